@@ -1,4 +1,6 @@
-# Import an existing fork by owner/repo (of the fork, not the source)
+# Import an existing fork by owner/repo (of the fork, not the source). A fork
+# owned by a user other than the authenticated one is imported with sudo set
+# to that user.
 import {
   to = gitea_fork.example
   id = "my-org/forked-repo"
@@ -16,3 +16,10 @@ resource "gitea_fork" "org_fork" {
   repo         = gitea_repository.source.name
   organization = "my-org"
 }
+
+# Fork to another user's account as a site administrator
+resource "gitea_fork" "impersonated_fork" {
+  owner = gitea_repository.source.username
+  repo  = gitea_repository.source.name
+  sudo  = "ci-bot"
+}
@@ -0,0 +1,2 @@
+# Import an existing organization label using the format: org/name
+terraform import gitea_org_label.priority_high testorg/priority/high
@@ -0,0 +1,5 @@
+# Import an existing organization label using the format: org/name
+import {
+  to = gitea_org_label.priority_high
+  id = "testorg/priority/high"
+}
@@ -0,0 +1,14 @@
+resource "gitea_org_label" "priority_high" {
+  org         = "testorg"
+  name        = "priority/high"
+  color       = "#d93f0b"
+  description = "Needs attention before the next release"
+  exclusive   = true
+}
+
+resource "gitea_org_label" "legacy" {
+  org      = "testorg"
+  name     = "wontfix"
+  color    = "#ffffff"
+  archived = true
+}
@@ -0,0 +1,2 @@
+# Import an existing release using the format: owner/repository/tag
+terraform import gitea_release.v1 testorg/test-repo-for-org/v1.0.0
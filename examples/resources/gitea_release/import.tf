@@ -0,0 +1,5 @@
+# Import an existing release using the format: owner/repository/tag
+import {
+  to = gitea_release.v1
+  id = "testorg/test-repo-for-org/v1.0.0"
+}
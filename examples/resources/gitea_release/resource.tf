@@ -0,0 +1,18 @@
+resource "gitea_release" "v1" {
+  owner            = "testorg"
+  repository       = "test-repo-for-org"
+  tag_name         = "v1.0.0"
+  target_commitish = "main"
+  title            = "v1.0.0"
+  note             = file("${path.module}/CHANGELOG.md")
+
+  assets = [
+    {
+      source = "${path.module}/dist/app-linux-amd64.tar.gz"
+    },
+    {
+      source = "${path.module}/dist/checksums.txt"
+      name   = "SHA256SUMS"
+    },
+  ]
+}
@@ -0,0 +1,2 @@
+# Import an existing collaborator using the format: owner/repository/username
+terraform import gitea_repository_collaborator.example testorg/test-repo-for-org/testuser
@@ -0,0 +1,5 @@
+# Import an existing collaborator using the format: owner/repository/username
+import {
+  to = gitea_repository_collaborator.example
+  id = "testorg/test-repo-for-org/testuser"
+}
@@ -0,0 +1,6 @@
+resource "gitea_repository_collaborator" "example" {
+  owner      = "testorg"
+  repository = "test-repo-for-org"
+  username   = "testuser"
+  permission = "read"
+}
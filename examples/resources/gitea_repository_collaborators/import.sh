@@ -0,0 +1,2 @@
+# Import the collaborators of an existing repository using the format: owner/repository
+terraform import gitea_repository_collaborators.example testorg/test-repo-for-org
@@ -0,0 +1,5 @@
+# Import the collaborators of an existing repository using the format: owner/repository
+import {
+  to = gitea_repository_collaborators.example
+  id = "testorg/test-repo-for-org"
+}
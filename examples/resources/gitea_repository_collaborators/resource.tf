@@ -0,0 +1,10 @@
+resource "gitea_repository_collaborators" "example" {
+  owner      = "testorg"
+  repository = "test-repo-for-org"
+
+  # Any other collaborator of the repository is removed
+  collaborators = {
+    "testuser" = "write"
+    "reviewer" = "read"
+  }
+}
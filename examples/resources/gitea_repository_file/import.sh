@@ -0,0 +1,3 @@
+# Import an existing file using the format: owner/repository/file or owner/repository/file:branch
+terraform import gitea_repository_file.codeowners testorg/test-repo-for-org/.gitea/CODEOWNERS
+terraform import gitea_repository_file.ci testorg/test-repo-for-org/.gitea/workflows/ci.yml:release/v1
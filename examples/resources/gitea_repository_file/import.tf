@@ -0,0 +1,5 @@
+# Import an existing file using the format: owner/repository/file or owner/repository/file:branch
+import {
+  to = gitea_repository_file.codeowners
+  id = "testorg/test-repo-for-org/.gitea/CODEOWNERS"
+}
@@ -0,0 +1,23 @@
+resource "gitea_repository_file" "codeowners" {
+  owner      = "testorg"
+  repository = "test-repo-for-org"
+  file       = ".gitea/CODEOWNERS"
+  content    = <<-EOT
+    * @testorg/platform
+    docs/ @testorg/writers
+  EOT
+
+  commit_message = "Manage CODEOWNERS with Terraform"
+  author_name    = "Terraform"
+  author_email   = "terraform@example.com"
+}
+
+# Take over a workflow that already exists on a release branch
+resource "gitea_repository_file" "ci" {
+  owner               = "testorg"
+  repository          = "test-repo-for-org"
+  file                = ".gitea/workflows/ci.yml"
+  branch              = "release/v1"
+  content             = file("${path.module}/ci.yml")
+  overwrite_on_create = true
+}
@@ -0,0 +1,2 @@
+# Import existing files using the format: owner/repository/file,file or owner/repository/file,file:branch
+terraform import gitea_repository_files.ci testorg/test-repo-for-org/.gitea/CODEOWNERS,.gitea/workflows/ci.yml,.gitea/workflows/release.yml
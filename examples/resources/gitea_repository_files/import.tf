@@ -0,0 +1,5 @@
+# Import existing files using the format: owner/repository/file,file or owner/repository/file,file:branch
+import {
+  to = gitea_repository_files.ci
+  id = "testorg/test-repo-for-org/.gitea/CODEOWNERS,.gitea/workflows/ci.yml,.gitea/workflows/release.yml"
+}
@@ -0,0 +1,15 @@
+# Commit the shared CI setup of a repository in a single commit per apply
+resource "gitea_repository_files" "ci" {
+  owner      = "testorg"
+  repository = "test-repo-for-org"
+
+  files = {
+    ".gitea/CODEOWNERS"            = "* @testorg/platform\n"
+    ".gitea/workflows/ci.yml"      = file("${path.module}/workflows/ci.yml")
+    ".gitea/workflows/release.yml" = file("${path.module}/workflows/release.yml")
+  }
+
+  commit_message = "Sync CI configuration"
+  author_name    = "Terraform"
+  author_email   = "terraform@example.com"
+}
@@ -0,0 +1,2 @@
+# Import an existing repository label using the format: owner/repository/name
+terraform import gitea_repository_label.bug testorg/test-repo-for-org/kind/bug
@@ -0,0 +1,5 @@
+# Import an existing repository label using the format: owner/repository/name
+import {
+  to = gitea_repository_label.bug
+  id = "testorg/test-repo-for-org/kind/bug"
+}
@@ -0,0 +1,8 @@
+resource "gitea_repository_label" "bug" {
+  owner       = "testorg"
+  repository  = "test-repo-for-org"
+  name        = "kind/bug"
+  color       = "#ee0701"
+  description = "Something is not working"
+  exclusive   = true
+}
@@ -0,0 +1,2 @@
+# Import an existing milestone using the format: owner/repository/title
+terraform import gitea_repository_milestone.v1 testorg/test-repo-for-org/v1.0
@@ -0,0 +1,5 @@
+# Import an existing milestone using the format: owner/repository/title
+import {
+  to = gitea_repository_milestone.v1
+  id = "testorg/test-repo-for-org/v1.0"
+}
@@ -0,0 +1,14 @@
+resource "gitea_repository_milestone" "v1" {
+  owner       = "testorg"
+  repository  = "test-repo-for-org"
+  title       = "v1.0"
+  description = "First stable release"
+  due_date    = "2026-12-31"
+}
+
+resource "gitea_repository_milestone" "v0" {
+  owner      = "testorg"
+  repository = "test-repo-for-org"
+  title      = "v0.9"
+  state      = "closed"
+}
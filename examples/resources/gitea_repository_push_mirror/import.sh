@@ -0,0 +1,2 @@
+# Import an existing push mirror using the format: owner/repository/remote_name
+terraform import gitea_repository_push_mirror.dr testorg/test-repo-for-org/remote_mirror_Xk3tQ9aB2c
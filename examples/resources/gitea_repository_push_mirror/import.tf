@@ -0,0 +1,5 @@
+# Import an existing push mirror using the format: owner/repository/remote_name
+import {
+  to = gitea_repository_push_mirror.dr
+  id = "testorg/test-repo-for-org/remote_mirror_Xk3tQ9aB2c"
+}
@@ -0,0 +1,18 @@
+variable "dr_token" {
+  type      = string
+  sensitive = true
+}
+
+# Mirror a production repository to the disaster-recovery Gitea
+resource "gitea_repository_push_mirror" "dr" {
+  owner           = "testorg"
+  repository      = "test-repo-for-org"
+  remote_address  = "https://gitea-dr.example.com/testorg/test-repo-for-org.git"
+  remote_username = "mirror-bot"
+  remote_password = var.dr_token
+  interval        = "1h"
+  sync_on_commit  = true
+
+  # Push right away whenever a new release is rolled out
+  sync_trigger = "v1.4.0"
+}
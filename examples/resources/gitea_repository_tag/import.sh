@@ -0,0 +1,2 @@
+# Import an existing tag using the format: owner/repository/tag
+terraform import gitea_repository_tag.v1 testorg/test-repo-for-org/v1.0.0
@@ -0,0 +1,16 @@
+# Lightweight tag on the head of a branch
+resource "gitea_repository_tag" "latest" {
+  owner      = "testorg"
+  repository = "test-repo-for-org"
+  name       = "nightly-2026-10-01"
+  target     = "main"
+}
+
+# Annotated tag on a specific commit
+resource "gitea_repository_tag" "v1" {
+  owner      = "testorg"
+  repository = "test-repo-for-org"
+  name       = "v1.0.0"
+  target     = "3f786850e387550fdab836ed7e6dc881de23001b"
+  message    = "First stable release"
+}
@@ -0,0 +1,2 @@
+# Import an existing tag protection rule using the format: username/name/name_pattern
+terraform import gitea_repository_tag_protection.releases "testorg/protected-repo/v*"
@@ -0,0 +1,5 @@
+# Import an existing tag protection rule by username/name/name_pattern
+import {
+  to = gitea_repository_tag_protection.releases
+  id = "testorg/protected-repo/v*"
+}
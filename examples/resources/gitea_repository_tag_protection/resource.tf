@@ -0,0 +1,14 @@
+resource "gitea_repository" "example" {
+  username = "testorg"
+  name     = "protected-repo"
+}
+
+# Only release managers may push version tags
+resource "gitea_repository_tag_protection" "releases" {
+  username     = gitea_repository.example.username
+  name         = gitea_repository.example.name
+  name_pattern = "v*"
+
+  whitelist_usernames = ["root"]
+  whitelist_teams     = ["release-managers"]
+}
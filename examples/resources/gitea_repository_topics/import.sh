@@ -0,0 +1,2 @@
+# Import the topics of an existing repository using the format: owner/repository
+terraform import gitea_repository_topics.example testorg/test-repo-for-org
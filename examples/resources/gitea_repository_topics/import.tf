@@ -0,0 +1,5 @@
+# Import the topics of an existing repository using the format: owner/repository
+import {
+  to = gitea_repository_topics.example
+  id = "testorg/test-repo-for-org"
+}
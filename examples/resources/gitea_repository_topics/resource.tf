@@ -0,0 +1,5 @@
+resource "gitea_repository_topics" "example" {
+  owner      = "testorg"
+  repository = "test-repo-for-org"
+  topics     = ["terraform", "team-platform", "tier-1"]
+}
@@ -3,3 +3,9 @@ import {
   to = gitea_token.example
   id = "12345"
 }
+
+# Import a token owned by another user; sets sudo to that user
+import {
+  to = gitea_token.service_account
+  id = "ci-bot/12345"
+}
@@ -8,3 +8,10 @@ output "token_value" {
   value     = gitea_token.example.token
   sensitive = true
 }
+
+# Create a token for a service account as a site administrator
+resource "gitea_token" "service_account" {
+  name   = "ci-token"
+  scopes = ["read:repository"]
+  sudo   = "ci-bot"
+}
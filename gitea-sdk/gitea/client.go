@@ -221,6 +221,57 @@ func (c *Client) SetSudo(sudo string) {
 	c.mutex.Unlock()
 }
 
+// WithSudo returns a copy of the client that impersonates the given user.
+// Unlike SetSudo it leaves c untouched, so it is safe to use while other
+// goroutines share c.
+func (c *Client) WithSudo(sudo string) *Client {
+	clone := c.clone()
+	clone.sudo = sudo
+	return clone
+}
+
+// WithContext returns a copy of the client that sends its requests with ctx.
+// Unlike SetContext it leaves c untouched.
+func (c *Client) WithContext(ctx context.Context) *Client {
+	clone := c.clone()
+	clone.ctx = ctx
+	return clone
+}
+
+// clone returns a new Client with the same configuration as c. A server
+// version that c has already loaded is carried over, so the copy does not
+// query the server again.
+func (c *Client) clone() *Client {
+	var serverVersion *version.Version
+	if !c.ignoreVersion && c.loadServerVersion() == nil {
+		serverVersion = c.serverVersion
+	}
+
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	clone := &Client{
+		url:           c.url,
+		accessToken:   c.accessToken,
+		username:      c.username,
+		password:      c.password,
+		otp:           c.otp,
+		sudo:          c.sudo,
+		userAgent:     c.userAgent,
+		debug:         c.debug,
+		httpsigner:    c.httpsigner,
+		client:        c.client,
+		ctx:           c.ctx,
+		ignoreVersion: c.ignoreVersion,
+	}
+	if serverVersion != nil {
+		clone.getVersionOnce.Do(func() {
+			clone.serverVersion = serverVersion
+		})
+	}
+	return clone
+}
+
 // SetUserAgent is an option for NewClient to set user-agent header
 func SetUserAgent(userAgent string) ClientOption {
 	return func(client *Client) error {
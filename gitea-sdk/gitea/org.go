@@ -144,6 +144,27 @@ func (c *Client) EditOrg(orgname string, opt EditOrgOption) (*Response, error) {
 	return c.doRequestWithStatusHandle("PATCH", fmt.Sprintf("/orgs/%s", orgname), jsonHeader, bytes.NewReader(body))
 }
 
+// RenameOrgOption options when renaming an organization
+type RenameOrgOption struct {
+	// New username for this org. This name cannot be in use yet by any other user.
+	NewName string `json:"new_name"`
+}
+
+// RenameOrg renames an organization
+func (c *Client) RenameOrg(orgname string, opt RenameOrgOption) (*Response, error) {
+	if err := escapeValidatePathSegments(&orgname); err != nil {
+		return nil, err
+	}
+	if err := c.checkServerVersionGreaterThanOrEqual(version1_23_0); err != nil {
+		return nil, err
+	}
+	body, err := json.Marshal(&opt)
+	if err != nil {
+		return nil, err
+	}
+	return c.doRequestWithStatusHandle("POST", fmt.Sprintf("/orgs/%s/rename", orgname), jsonHeader, bytes.NewReader(body))
+}
+
 // DeleteOrg deletes an organization
 func (c *Client) DeleteOrg(orgname string) (*Response, error) {
 	if err := escapeValidatePathSegments(&orgname); err != nil {
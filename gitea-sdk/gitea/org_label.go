@@ -40,6 +40,8 @@ type CreateOrgLabelOption struct {
 	Description string `json:"description"`
 	// Whether this is an exclusive label
 	Exclusive bool `json:"exclusive"`
+	// Whether the label is archived
+	IsArchived bool `json:"is_archived"`
 }
 
 // Validate the CreateLabelOption struct
@@ -90,6 +92,8 @@ type EditOrgLabelOption struct {
 	Description *string `json:"description"`
 	// Whether this is an exclusive label
 	Exclusive *bool `json:"exclusive,omitempty"`
+	// Whether the label is archived
+	IsArchived *bool `json:"is_archived,omitempty"`
 }
 
 // EditOrgLabel edits an existing org-level label by ID
@@ -118,6 +118,36 @@ type FileDeleteResponse struct {
 	Verification *PayloadCommitVerification `json:"verification"`
 }
 
+// ChangeFileOperation describes the change of a single file within a ChangeFilesOptions commit
+type ChangeFileOperation struct {
+	// indicates what to do with the file: "create", "update" or "delete"
+	Operation string `json:"operation"`
+	// path to the existing or new file
+	Path string `json:"path"`
+	// new or updated file content, must be base64 encoded
+	ContentBase64 string `json:"content,omitempty"`
+	// sha is the SHA for the file that already exists, required for update or delete
+	SHA string `json:"sha,omitempty"`
+	// old path of the file to move
+	FromPath string `json:"from_path,omitempty"`
+}
+
+// ChangeFilesOptions options for creating, updating or deleting multiple files in one commit
+// Note: `author` and `committer` are optional (if only one is given, it will be used for the other, otherwise the authenticated user will be used)
+type ChangeFilesOptions struct {
+	FileOptions
+	// list of file operations
+	// required: true
+	Files []*ChangeFileOperation `json:"files"`
+}
+
+// FilesResponse contains information about multiple files from a repo
+type FilesResponse struct {
+	Files        []*ContentsResponse        `json:"files"`
+	Commit       *FileCommitResponse        `json:"commit"`
+	Verification *PayloadCommitVerification `json:"verification"`
+}
+
 // GetFile downloads a file of repository, ref can be branch/tag/commit.
 // it optional can resolve lfs pointers and server the file instead
 // e.g.: ref -> master, filepath -> README.md (no leading slash)
@@ -267,6 +297,25 @@ func (c *Client) DeleteFile(owner, repo, filepath string, opt DeleteFileOptions)
 	return resp, nil
 }
 
+// ChangeFiles creates, updates or deletes multiple files in a repository with a single commit
+func (c *Client) ChangeFiles(owner, repo string, opt ChangeFilesOptions) (*FilesResponse, *Response, error) {
+	var err error
+	if opt.BranchName, err = c.setDefaultBranchForOldVersions(owner, repo, opt.BranchName); err != nil {
+		return nil, nil, err
+	}
+	if err := escapeValidatePathSegments(&owner, &repo); err != nil {
+		return nil, nil, err
+	}
+
+	body, err := json.Marshal(&opt)
+	if err != nil {
+		return nil, nil, err
+	}
+	fr := new(FilesResponse)
+	resp, err := c.getParsedResponse("POST", fmt.Sprintf("/repos/%s/%s/contents", owner, repo), jsonHeader, bytes.NewReader(body), fr)
+	return fr, resp, err
+}
+
 func (c *Client) setDefaultBranchForOldVersions(owner, repo, branch string) (string, error) {
 	if len(branch) == 0 {
 		// Gitea >= 1.12.0 Use DefaultBranch on "", mimic this for older versions
@@ -74,3 +74,11 @@ func (c *Client) DeletePushMirror(user, repo, remoteName string) (*Response, err
 	}
 	return c.doRequestWithStatusHandle("DELETE", fmt.Sprintf("/repos/%s/%s/push_mirrors/%s", user, repo, remoteName), nil, nil)
 }
+
+// SyncPushMirrors pushes all push mirrors of a repository
+func (c *Client) SyncPushMirrors(user, repo string) (*Response, error) {
+	if err := escapeValidatePathSegments(&user, &repo); err != nil {
+		return nil, err
+	}
+	return c.doRequestWithStatusHandle("POST", fmt.Sprintf("/repos/%s/%s/push_mirrors-sync", user, repo), nil, nil)
+}
@@ -81,13 +81,25 @@ type ListAccessTokensOptions struct {
 	ListOptions
 }
 
+// tokenOwner returns the user whose access tokens are managed: the
+// impersonated user when sudo is set, otherwise the BasicAuth user.
+func (c *Client) tokenOwner() (string, error) {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+	if len(c.username) == 0 {
+		return "", fmt.Errorf("\"username\" not set: only BasicAuth allowed")
+	}
+	if len(c.sudo) != 0 {
+		return c.sudo, nil
+	}
+	return c.username, nil
+}
+
 // ListAccessTokens lists all the access tokens of user
 func (c *Client) ListAccessTokens(opts ListAccessTokensOptions) ([]*AccessToken, *Response, error) {
-	c.mutex.RLock()
-	username := c.username
-	c.mutex.RUnlock()
-	if len(username) == 0 {
-		return nil, nil, fmt.Errorf("\"username\" not set: only BasicAuth allowed")
+	username, err := c.tokenOwner()
+	if err != nil {
+		return nil, nil, err
 	}
 	opts.setDefaults()
 	tokens := make([]*AccessToken, 0, opts.PageSize)
@@ -103,11 +115,9 @@ type CreateAccessTokenOption struct {
 
 // CreateAccessToken create one access token with options
 func (c *Client) CreateAccessToken(opt CreateAccessTokenOption) (*AccessToken, *Response, error) {
-	c.mutex.RLock()
-	username := c.username
-	c.mutex.RUnlock()
-	if len(username) == 0 {
-		return nil, nil, fmt.Errorf("\"username\" not set: only BasicAuth allowed")
+	username, err := c.tokenOwner()
+	if err != nil {
+		return nil, nil, err
 	}
 	body, err := json.Marshal(&opt)
 	if err != nil {
@@ -120,11 +130,9 @@ func (c *Client) CreateAccessToken(opt CreateAccessTokenOption) (*AccessToken, *
 
 // DeleteAccessToken delete token, identified by ID and if not available by name
 func (c *Client) DeleteAccessToken(value interface{}) (*Response, error) {
-	c.mutex.RLock()
-	username := c.username
-	c.mutex.RUnlock()
-	if len(username) == 0 {
-		return nil, fmt.Errorf("\"username\" not set: only BasicAuth allowed")
+	username, err := c.tokenOwner()
+	if err != nil {
+		return nil, err
 	}
 
 	token := ""
@@ -287,19 +287,21 @@ func (d *branchProtectionDataSource) Configure(_ context.Context, req datasource
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
 }
 
 func (d *branchProtectionDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	client := d.client.WithContext(ctx)
+
 	var data branchProtectionDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -311,7 +313,7 @@ func (d *branchProtectionDataSource) Read(ctx context.Context, req datasource.Re
 	repo := data.Repo.ValueString()
 	branchName := data.Name.ValueString()
 
-	protection, httpResp, err := d.client.GetBranchProtection(owner, repo, branchName)
+	protection, httpResp, err := client.GetBranchProtection(owner, repo, branchName)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
 			resp.Diagnostics.AddError(
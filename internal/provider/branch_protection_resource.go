@@ -9,6 +9,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
 	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -24,6 +25,7 @@ var (
 	_ resource.Resource                   = &repositoryBranchProtectionResource{}
 	_ resource.ResourceWithConfigure      = &repositoryBranchProtectionResource{}
 	_ resource.ResourceWithImportState    = &repositoryBranchProtectionResource{}
+	_ resource.ResourceWithModifyPlan     = &repositoryBranchProtectionResource{}
 	_ resource.ResourceWithValidateConfig = &repositoryBranchProtectionResource{}
 )
 
@@ -32,7 +34,8 @@ func NewRepositoryBranchProtectionResource() resource.Resource {
 }
 
 type repositoryBranchProtectionResource struct {
-	client *gitea.Client
+	client       *gitea.Client
+	providerData *giteaProviderData
 }
 
 // repositoryBranchProtectionResourceModel describes the resource data model.
@@ -92,11 +95,12 @@ func (r *repositoryBranchProtectionResource) Schema(_ context.Context, _ resourc
 		Attributes: map[string]schema.Attribute{
 			// Required identification fields
 			"username": schema.StringAttribute{
-				Required:            true,
-				Description:         "User name or organization name.",
-				MarkdownDescription: "User name or organization name.",
+				Optional:            true,
+				Computed:            true,
+				Description:         "User name or organization name. Defaults to the provider's default_owner.",
+				MarkdownDescription: "User name or organization name. Defaults to the provider's `default_owner`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -319,19 +323,31 @@ func (r *repositoryBranchProtectionResource) Configure(_ context.Context, req re
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted username from the provider's default_owner.
+func (r *repositoryBranchProtectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("username"), req, resp)
 }
 
 func (r *repositoryBranchProtectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan repositoryBranchProtectionResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -395,7 +411,7 @@ func (r *repositoryBranchProtectionResource) Create(ctx context.Context, req res
 		return
 	}
 
-	protection, _, err := r.client.CreateBranchProtection(
+	protection, _, err := client.CreateBranchProtection(
 		plan.Username.ValueString(),
 		plan.Name.ValueString(),
 		createOpts,
@@ -416,6 +432,8 @@ func (r *repositoryBranchProtectionResource) Create(ctx context.Context, req res
 }
 
 func (r *repositoryBranchProtectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state repositoryBranchProtectionResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -427,7 +445,7 @@ func (r *repositoryBranchProtectionResource) Read(ctx context.Context, req resou
 	name := state.Name.ValueString()
 	ruleName := state.RuleName.ValueString()
 
-	protection, httpResp, err := r.client.GetBranchProtection(username, name, ruleName)
+	protection, httpResp, err := client.GetBranchProtection(username, name, ruleName)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.State.RemoveResource(ctx)
@@ -452,6 +470,8 @@ func (r *repositoryBranchProtectionResource) Read(ctx context.Context, req resou
 }
 
 func (r *repositoryBranchProtectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan repositoryBranchProtectionResourceModel
 	var state repositoryBranchProtectionResourceModel
 
@@ -529,7 +549,7 @@ func (r *repositoryBranchProtectionResource) Update(ctx context.Context, req res
 		return
 	}
 
-	protection, _, err := r.client.EditBranchProtection(
+	protection, _, err := client.EditBranchProtection(
 		state.Username.ValueString(),
 		state.Name.ValueString(),
 		state.RuleName.ValueString(),
@@ -555,6 +575,8 @@ func (r *repositoryBranchProtectionResource) Update(ctx context.Context, req res
 }
 
 func (r *repositoryBranchProtectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state repositoryBranchProtectionResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -566,7 +588,7 @@ func (r *repositoryBranchProtectionResource) Delete(ctx context.Context, req res
 	name := state.Name.ValueString()
 	ruleName := state.RuleName.ValueString()
 
-	httpResp, err := r.client.DeleteBranchProtection(username, name, ruleName)
+	httpResp, err := client.DeleteBranchProtection(username, name, ruleName)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			return
@@ -581,6 +603,8 @@ func (r *repositoryBranchProtectionResource) Delete(ctx context.Context, req res
 }
 
 func (r *repositoryBranchProtectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
 	// Import format: "username/name/rule_name"
 	id := req.ID
 
@@ -597,7 +621,7 @@ func (r *repositoryBranchProtectionResource) ImportState(ctx context.Context, re
 	name := parts[1]
 	ruleName := parts[2]
 
-	protection, httpResp, err := r.client.GetBranchProtection(username, name, ruleName)
+	protection, httpResp, err := client.GetBranchProtection(username, name, ruleName)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.Diagnostics.AddError(
@@ -34,6 +34,7 @@ type forkResourceModel struct {
 
 	// Optional
 	Organization types.String `tfsdk:"organization"`
+	Sudo         types.String `tfsdk:"sudo"`
 
 	// Computed
 	Id types.String `tfsdk:"id"`
@@ -76,6 +77,15 @@ func (r *forkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 
+			"sudo": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Username to impersonate when forking.",
+				MarkdownDescription: "Username to impersonate when forking. Without `organization` the fork is created in this user's namespace. Requires site administrator credentials.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
 			// Computed
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -94,16 +104,16 @@ func (r *forkResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 func (r *forkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -120,7 +130,7 @@ func (r *forkResource) Create(ctx context.Context, req resource.CreateRequest, r
 		opt.Organization = &org
 	}
 
-	fork, _, err := r.client.CreateFork(plan.Owner.ValueString(), plan.Repo.ValueString(), opt)
+	fork, _, err := clientFor(ctx, r.client, plan.Sudo).CreateFork(plan.Owner.ValueString(), plan.Repo.ValueString(), opt)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Fork",
@@ -142,13 +152,15 @@ func (r *forkResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	client := clientFor(ctx, r.client, state.Sudo)
+
 	// Determine the fork owner (either organization or current user)
 	var forkOwner string
 	if !state.Organization.IsNull() && !state.Organization.IsUnknown() {
 		forkOwner = state.Organization.ValueString()
 	} else {
 		// Get current user
-		user, _, err := r.client.GetMyUserInfo()
+		user, _, err := client.GetMyUserInfo()
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading Fork",
@@ -162,7 +174,7 @@ func (r *forkResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	// The fork name defaults to the source repo name
 	repoName := state.Repo.ValueString()
 
-	repo, httpResp, err := r.client.GetRepo(forkOwner, repoName)
+	repo, httpResp, err := client.GetRepo(forkOwner, repoName)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.State.RemoveResource(ctx)
@@ -197,13 +209,15 @@ func (r *forkResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
+	client := clientFor(ctx, r.client, state.Sudo)
+
 	// Determine the fork owner (either organization or current user)
 	var forkOwner string
 	if !state.Organization.IsNull() && !state.Organization.IsUnknown() {
 		forkOwner = state.Organization.ValueString()
 	} else {
 		// Get current user
-		user, _, err := r.client.GetMyUserInfo()
+		user, _, err := client.GetMyUserInfo()
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Deleting Fork",
@@ -217,7 +231,7 @@ func (r *forkResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	// The fork name defaults to the source repo name
 	repoName := state.Repo.ValueString()
 
-	_, err := r.client.DeleteRepo(forkOwner, repoName)
+	_, err := client.DeleteRepo(forkOwner, repoName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Fork",
@@ -228,6 +242,8 @@ func (r *forkResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *forkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
 	// Import format: owner/repo (of the fork, not the source)
 	parts := strings.Split(req.ID, "/")
 	if len(parts) != 2 {
@@ -242,7 +258,7 @@ func (r *forkResource) ImportState(ctx context.Context, req resource.ImportState
 	forkRepo := parts[1]
 
 	// Fetch the repository to get full details
-	repo, httpResp, err := r.client.GetRepo(forkOwner, forkRepo)
+	repo, httpResp, err := client.GetRepo(forkOwner, forkRepo)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.Diagnostics.AddError(
@@ -276,11 +292,16 @@ func (r *forkResource) ImportState(ctx context.Context, req resource.ImportState
 		data.Owner = types.StringValue(repo.Parent.Owner.UserName)
 	}
 
-	// Check if fork owner is an organization or the current user
-	user, _, _ := r.client.GetMyUserInfo()
+	// Check if fork owner is the current user, an organization, or another
+	// user whose fork is managed through sudo
+	user, _, _ := client.GetMyUserInfo()
 	if user != nil && user.UserName == forkOwner {
 		// Fork is owned by current user, no organization specified
 		data.Organization = types.StringNull()
+	} else if _, orgResp, err := client.GetOrg(forkOwner); err != nil && orgResp != nil && orgResp.StatusCode == 404 {
+		// Fork is owned by another user, manage it by impersonating them
+		data.Organization = types.StringNull()
+		data.Sudo = types.StringValue(forkOwner)
 	} else {
 		// Fork is owned by an organization
 		data.Organization = types.StringValue(forkOwner)
@@ -17,13 +17,15 @@ import (
 var _ resource.Resource = (*gitHookResource)(nil)
 var _ resource.ResourceWithConfigure = (*gitHookResource)(nil)
 var _ resource.ResourceWithImportState = (*gitHookResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*gitHookResource)(nil)
 
 func NewGitHookResource() resource.Resource {
 	return &gitHookResource{}
 }
 
 type gitHookResource struct {
-	client *gitea.Client
+	client       *gitea.Client
+	providerData *giteaProviderData
 }
 
 type gitHookResourceModel struct {
@@ -46,10 +48,11 @@ func (r *gitHookResource) Schema(ctx context.Context, req resource.SchemaRequest
 		MarkdownDescription: "Manages a repository git hook",
 		Attributes: map[string]schema.Attribute{
 			"owner": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Owner of the repository",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"repository": schema.StringAttribute{
@@ -83,19 +86,31 @@ func (r *gitHookResource) Configure(ctx context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner.
+func (r *gitHookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
 }
 
 func (r *gitHookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data gitHookResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -107,7 +122,7 @@ func (r *gitHookResource) Create(ctx context.Context, req resource.CreateRequest
 		Content: data.Content.ValueString(),
 	}
 
-	_, err := r.client.EditRepoGitHook(
+	_, err := client.EditRepoGitHook(
 		data.Owner.ValueString(),
 		data.Repo.ValueString(),
 		data.Name.ValueString(),
@@ -119,7 +134,7 @@ func (r *gitHookResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	// Read back to get the is_active status
-	hook, _, err := r.client.GetRepoGitHook(
+	hook, _, err := client.GetRepoGitHook(
 		data.Owner.ValueString(),
 		data.Repo.ValueString(),
 		data.Name.ValueString(),
@@ -135,6 +150,8 @@ func (r *gitHookResource) Create(ctx context.Context, req resource.CreateRequest
 }
 
 func (r *gitHookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data gitHookResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -142,7 +159,7 @@ func (r *gitHookResource) Read(ctx context.Context, req resource.ReadRequest, re
 		return
 	}
 
-	hook, httpResp, err := r.client.GetRepoGitHook(
+	hook, httpResp, err := client.GetRepoGitHook(
 		data.Owner.ValueString(),
 		data.Repo.ValueString(),
 		data.Name.ValueString(),
@@ -164,6 +181,8 @@ func (r *gitHookResource) Read(ctx context.Context, req resource.ReadRequest, re
 }
 
 func (r *gitHookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data gitHookResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -175,7 +194,7 @@ func (r *gitHookResource) Update(ctx context.Context, req resource.UpdateRequest
 		Content: data.Content.ValueString(),
 	}
 
-	_, err := r.client.EditRepoGitHook(
+	_, err := client.EditRepoGitHook(
 		data.Owner.ValueString(),
 		data.Repo.ValueString(),
 		data.Name.ValueString(),
@@ -187,7 +206,7 @@ func (r *gitHookResource) Update(ctx context.Context, req resource.UpdateRequest
 	}
 
 	// Read back to get the updated is_active status
-	hook, _, err := r.client.GetRepoGitHook(
+	hook, _, err := client.GetRepoGitHook(
 		data.Owner.ValueString(),
 		data.Repo.ValueString(),
 		data.Name.ValueString(),
@@ -203,6 +222,8 @@ func (r *gitHookResource) Update(ctx context.Context, req resource.UpdateRequest
 }
 
 func (r *gitHookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data gitHookResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -210,7 +231,7 @@ func (r *gitHookResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	_, err := r.client.DeleteRepoGitHook(
+	_, err := client.DeleteRepoGitHook(
 		data.Owner.ValueString(),
 		data.Repo.ValueString(),
 		data.Name.ValueString(),
@@ -31,6 +31,9 @@ type gpgKeyResourceModel struct {
 	// Required
 	ArmoredPublicKey types.String `tfsdk:"armored_public_key"`
 
+	// Optional
+	Sudo types.String `tfsdk:"sudo"`
+
 	// Computed
 	Id                types.Int64  `tfsdk:"id"`
 	PrimaryKeyId      types.String `tfsdk:"primary_key_id"`
@@ -58,6 +61,14 @@ func (r *gpgKeyResource) Schema(ctx context.Context, req resource.SchemaRequest,
 					stringplanmodifier.RequiresReplace(),
 				},
 			},
+			"sudo": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Username to impersonate when managing the key.",
+				MarkdownDescription: "Username to impersonate when managing the key. The key is added to this user's account instead of the authenticated user's; requires site administrator credentials.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"id": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The ID of the GPG key",
@@ -106,16 +117,16 @@ func (r *gpgKeyResource) Configure(ctx context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 func (r *gpgKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -130,7 +141,7 @@ func (r *gpgKeyResource) Create(ctx context.Context, req resource.CreateRequest,
 		ArmoredKey: data.ArmoredPublicKey.ValueString(),
 	}
 
-	key, _, err := r.client.CreateGPGKey(opt)
+	key, _, err := clientFor(ctx, r.client, data.Sudo).CreateGPGKey(opt)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create GPG key, got error: %s", err))
 		return
@@ -161,7 +172,7 @@ func (r *gpgKeyResource) Read(ctx context.Context, req resource.ReadRequest, res
 		return
 	}
 
-	key, httpResp, err := r.client.GetGPGKey(data.Id.ValueInt64())
+	key, httpResp, err := clientFor(ctx, r.client, data.Sudo).GetGPGKey(data.Id.ValueInt64())
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.State.RemoveResource(ctx)
@@ -203,7 +214,7 @@ func (r *gpgKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	_, err := r.client.DeleteGPGKey(data.Id.ValueInt64())
+	_, err := clientFor(ctx, r.client, data.Sudo).DeleteGPGKey(data.Id.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete GPG key, got error: %s", err))
 		return
@@ -211,14 +222,19 @@ func (r *gpgKeyResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *gpgKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	keyID, err := strconv.ParseInt(req.ID, 10, 64)
+	// Import format: id, or username/id when managed through sudo
+	sudo, rawID := splitSudoImportID(req.ID)
+	keyID, err := strconv.ParseInt(rawID, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid ID",
-			fmt.Sprintf("Expected a numeric ID, got: %s", req.ID),
+			fmt.Sprintf("Expected a numeric ID or username/ID, got: %s", req.ID),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), keyID)...)
+	if sudo != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sudo"), sudo)...)
+	}
 }
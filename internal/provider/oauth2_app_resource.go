@@ -12,6 +12,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
@@ -33,7 +34,8 @@ type oauth2AppResourceModel struct {
 	RedirectUris types.List   `tfsdk:"redirect_uris"`
 
 	// Optional
-	ConfidentialClient types.Bool `tfsdk:"confidential_client"`
+	ConfidentialClient types.Bool   `tfsdk:"confidential_client"`
+	Sudo               types.String `tfsdk:"sudo"`
 
 	// Computed
 	Id           types.Int64  `tfsdk:"id"`
@@ -65,6 +67,14 @@ func (r *oauth2AppResource) Schema(ctx context.Context, req resource.SchemaReque
 				Default:             booldefault.StaticBool(false),
 				MarkdownDescription: "Whether this is a confidential client",
 			},
+			"sudo": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Username to impersonate when managing the application.",
+				MarkdownDescription: "Username to impersonate when managing the application. The application is owned by this user instead of the authenticated user; requires site administrator credentials.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
 			"id": schema.Int64Attribute{
 				Computed:            true,
 				MarkdownDescription: "The ID of the OAuth2 application",
@@ -94,16 +104,16 @@ func (r *oauth2AppResource) Configure(ctx context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 func (r *oauth2AppResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -126,7 +136,7 @@ func (r *oauth2AppResource) Create(ctx context.Context, req resource.CreateReque
 		RedirectURIs:       redirectUris,
 	}
 
-	oauth2, _, err := r.client.CreateOauth2(opt)
+	oauth2, _, err := clientFor(ctx, r.client, data.Sudo).CreateOauth2(opt)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create OAuth2 application, got error: %s", err))
 		return
@@ -150,7 +160,7 @@ func (r *oauth2AppResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	oauth2, httpResp, err := r.client.GetOauth2(data.Id.ValueInt64())
+	oauth2, httpResp, err := clientFor(ctx, r.client, data.Sudo).GetOauth2(data.Id.ValueInt64())
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.State.RemoveResource(ctx)
@@ -200,7 +210,7 @@ func (r *oauth2AppResource) Update(ctx context.Context, req resource.UpdateReque
 		RedirectURIs:       redirectUris,
 	}
 
-	oauth2, _, err := r.client.UpdateOauth2(data.Id.ValueInt64(), opt)
+	oauth2, _, err := clientFor(ctx, r.client, data.Sudo).UpdateOauth2(data.Id.ValueInt64(), opt)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update OAuth2 application, got error: %s", err))
 		return
@@ -226,7 +236,7 @@ func (r *oauth2AppResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	_, err := r.client.DeleteOauth2(data.Id.ValueInt64())
+	_, err := clientFor(ctx, r.client, data.Sudo).DeleteOauth2(data.Id.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete OAuth2 application, got error: %s", err))
 		return
@@ -234,14 +244,19 @@ func (r *oauth2AppResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *oauth2AppResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	appID, err := strconv.ParseInt(req.ID, 10, 64)
+	// Import format: id, or username/id when managed through sudo
+	sudo, rawID := splitSudoImportID(req.ID)
+	appID, err := strconv.ParseInt(rawID, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid ID",
-			fmt.Sprintf("Expected a numeric ID, got: %s", req.ID),
+			fmt.Sprintf("Expected a numeric ID or username/ID, got: %s", req.ID),
 		)
 		return
 	}
 
 	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), appID)...)
+	if sudo != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("sudo"), sudo)...)
+	}
 }
@@ -17,13 +17,15 @@ import (
 var _ resource.Resource = (*orgActionsSecretResource)(nil)
 var _ resource.ResourceWithConfigure = (*orgActionsSecretResource)(nil)
 var _ resource.ResourceWithImportState = (*orgActionsSecretResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*orgActionsSecretResource)(nil)
 
 func NewOrgActionsSecretResource() resource.Resource {
 	return &orgActionsSecretResource{}
 }
 
 type orgActionsSecretResource struct {
-	client *gitea.Client
+	client       *gitea.Client
+	providerData *giteaProviderData
 }
 
 type orgActionsSecretResourceModel struct {
@@ -45,8 +47,8 @@ func (r *orgActionsSecretResource) Metadata(ctx context.Context, req resource.Me
 
 func (r *orgActionsSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		Description:         "Manages an organization actions secret in Gitea. Organization secrets are available to all repositories within the organization for GitHub Actions workflows.",
-		MarkdownDescription: "Manages an organization actions secret in Gitea. Organization secrets are available to all repositories within the organization for GitHub Actions workflows.\n\n## Import\n\nOrganization action secrets can be imported using the format `org/secretName`:\n\n```shell\nterraform import gitea_org_actions_secret.example myorg/MY_SECRET\n```",
+		Description:         "Manages an organization actions secret in Gitea. Organization secrets are available to all repositories within the organization for GitHub Actions workflows. Requires Gitea 1.21 or later.",
+		MarkdownDescription: "Manages an organization actions secret in Gitea. Organization secrets are available to all repositories within the organization for GitHub Actions workflows. Requires Gitea 1.21 or later.\n\n## Import\n\nOrganization action secrets can be imported using the format `org/secretName`:\n\n```shell\nterraform import gitea_org_actions_secret.example myorg/MY_SECRET\n```",
 		Attributes: map[string]schema.Attribute{
 			"org": schema.StringAttribute{
 				Required:            true,
@@ -89,19 +91,31 @@ func (r *orgActionsSecretResource) Configure(ctx context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fails planning early on servers that predate the organization actions secrets API.
+func (r *orgActionsSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.providerData.checkServerVersion("The gitea_org_actions_secret resource", "1.21.0")...)
 }
 
 func (r *orgActionsSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data orgActionsSecretResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -115,7 +129,7 @@ func (r *orgActionsSecretResource) Create(ctx context.Context, req resource.Crea
 		Description: data.Description.ValueString(),
 	}
 
-	_, err := r.client.CreateOrgActionSecret(data.Org.ValueString(), opt)
+	_, err := client.CreateOrgActionSecret(data.Org.ValueString(), opt)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Organization Actions Secret",
@@ -125,7 +139,7 @@ func (r *orgActionsSecretResource) Create(ctx context.Context, req resource.Crea
 	}
 
 	// Read back to get created timestamp
-	secrets, _, err := r.client.ListOrgActionSecret(data.Org.ValueString(), gitea.ListOrgActionSecretOption{})
+	secrets, _, err := client.ListOrgActionSecret(data.Org.ValueString(), gitea.ListOrgActionSecretOption{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Created Secret",
@@ -148,6 +162,8 @@ func (r *orgActionsSecretResource) Create(ctx context.Context, req resource.Crea
 }
 
 func (r *orgActionsSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data orgActionsSecretResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -155,7 +171,7 @@ func (r *orgActionsSecretResource) Read(ctx context.Context, req resource.ReadRe
 		return
 	}
 
-	secrets, _, err := r.client.ListOrgActionSecret(data.Org.ValueString(), gitea.ListOrgActionSecretOption{})
+	secrets, _, err := client.ListOrgActionSecret(data.Org.ValueString(), gitea.ListOrgActionSecretOption{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Organization Actions Secrets",
@@ -188,6 +204,8 @@ func (r *orgActionsSecretResource) Read(ctx context.Context, req resource.ReadRe
 }
 
 func (r *orgActionsSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data orgActionsSecretResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -202,7 +220,7 @@ func (r *orgActionsSecretResource) Update(ctx context.Context, req resource.Upda
 		Description: data.Description.ValueString(),
 	}
 
-	_, err := r.client.CreateOrgActionSecret(data.Org.ValueString(), opt)
+	_, err := client.CreateOrgActionSecret(data.Org.ValueString(), opt)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Organization Actions Secret",
@@ -215,6 +233,8 @@ func (r *orgActionsSecretResource) Update(ctx context.Context, req resource.Upda
 }
 
 func (r *orgActionsSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data orgActionsSecretResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -222,7 +242,7 @@ func (r *orgActionsSecretResource) Delete(ctx context.Context, req resource.Dele
 		return
 	}
 
-	_, err := r.client.DeleteOrgActionSecret(data.Org.ValueString(), data.Name.ValueString())
+	_, err := client.DeleteOrgActionSecret(data.Org.ValueString(), data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Organization Actions Secret",
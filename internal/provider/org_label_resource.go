@@ -0,0 +1,331 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &orgLabelResource{}
+	_ resource.ResourceWithConfigure   = &orgLabelResource{}
+	_ resource.ResourceWithImportState = &orgLabelResource{}
+	_ resource.ResourceWithModifyPlan  = &orgLabelResource{}
+)
+
+func NewOrgLabelResource() resource.Resource {
+	return &orgLabelResource{}
+}
+
+type orgLabelResource struct {
+	client       *gitea.Client
+	providerData *giteaProviderData
+}
+
+type orgLabelResourceModel struct {
+	// Required
+	Org   types.String `tfsdk:"org"`
+	Name  types.String `tfsdk:"name"`
+	Color types.String `tfsdk:"color"`
+
+	// Optional
+	Description types.String `tfsdk:"description"`
+	Exclusive   types.Bool   `tfsdk:"exclusive"`
+	Archived    types.Bool   `tfsdk:"archived"`
+
+	// Computed
+	Id  types.Int64  `tfsdk:"id"`
+	URL types.String `tfsdk:"url"`
+}
+
+func (r *orgLabelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_org_label"
+}
+
+func (r *orgLabelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages an issue label of a Gitea organization.",
+		MarkdownDescription: "Manages an issue label of a Gitea organization. Organization labels can be used on issues and pull requests of every repository of the organization.",
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"org": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the organization.",
+				MarkdownDescription: "Name of the organization.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the label. A name such as kind/bug makes the label scoped.",
+				MarkdownDescription: "Name of the label. A name such as `kind/bug` makes the label scoped.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"color": schema.StringAttribute{
+				Required:            true,
+				Description:         "Color of the label as a hex code, e.g. ee0701 or #ee0701.",
+				MarkdownDescription: "Color of the label as a hex code, e.g. `ee0701` or `#ee0701`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(labelColorRegexp, "must be a 6-digit hex color code"),
+				},
+			},
+
+			// Optional
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "Description of the label.",
+				MarkdownDescription: "Description of the label.",
+			},
+			"exclusive": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether an issue can only have one label of the scope of this label. Only applies to scoped labels.",
+				MarkdownDescription: "Whether an issue can only have one label of the scope of this label. Only applies to scoped labels.",
+			},
+			"archived": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether the label is archived, which hides it when labeling issues. Requires Gitea 1.22 or later.",
+				MarkdownDescription: "Whether the label is archived, which hides it when labeling issues. Requires Gitea 1.22 or later.",
+			},
+
+			// Computed
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The ID of the label.",
+				MarkdownDescription: "The ID of the label.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				Description:         "API URL of the label.",
+				MarkdownDescription: "API URL of the label.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *orgLabelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*giteaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fails planning early when archiving the label is not supported.
+func (r *orgLabelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	resp.Diagnostics.Append(checkLabelArchiveSupport(ctx, r.providerData, "gitea_org_label", req)...)
+}
+
+func (r *orgLabelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan orgLabelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := plan.Org.ValueString()
+
+	label, _, err := client.CreateOrgLabel(org, gitea.CreateOrgLabelOption{
+		Name:        plan.Name.ValueString(),
+		Color:       plan.Color.ValueString(),
+		Description: plan.Description.ValueString(),
+		Exclusive:   plan.Exclusive.ValueBool(),
+		IsArchived:  plan.Archived.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Label",
+			fmt.Sprintf("Could not create label '%s' in organization '%s': %s", plan.Name.ValueString(), org, err.Error()),
+		)
+		return
+	}
+
+	plan.Id = types.Int64Value(label.ID)
+	plan.URL = types.StringValue(label.URL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *orgLabelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state orgLabelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := state.Org.ValueString()
+
+	label, httpResp, err := client.GetOrgLabel(org, state.Id.ValueInt64())
+	if err != nil {
+		// Handle 404 - label was deleted outside of Terraform
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Label",
+			fmt.Sprintf("Could not read label %d of organization '%s': %s", state.Id.ValueInt64(), org, err.Error()),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(label.Name)
+	state.Color = types.StringValue(labelColor(state.Color.ValueString(), label.Color))
+	state.Description = types.StringValue(label.Description)
+	state.Exclusive = types.BoolValue(label.Exclusive)
+	state.Archived = types.BoolValue(label.IsArchived)
+	state.URL = types.StringValue(label.URL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *orgLabelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan, state orgLabelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := plan.Org.ValueString()
+
+	opt := gitea.EditOrgLabelOption{
+		Name:        plan.Name.ValueStringPointer(),
+		Color:       plan.Color.ValueStringPointer(),
+		Description: plan.Description.ValueStringPointer(),
+		Exclusive:   plan.Exclusive.ValueBoolPointer(),
+	}
+	// Only sent when changed, so servers without label archiving accept the edit
+	if !plan.Archived.Equal(state.Archived) {
+		opt.IsArchived = plan.Archived.ValueBoolPointer()
+	}
+
+	label, _, err := client.EditOrgLabel(org, state.Id.ValueInt64(), opt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Label",
+			fmt.Sprintf("Could not update label %d of organization '%s': %s", state.Id.ValueInt64(), org, err.Error()),
+		)
+		return
+	}
+
+	plan.Id = state.Id
+	plan.URL = types.StringValue(label.URL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *orgLabelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state orgLabelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	org := state.Org.ValueString()
+
+	httpResp, err := client.DeleteOrgLabel(org, state.Id.ValueInt64())
+	if err != nil {
+		// If already deleted (404), consider it a success
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Label",
+			fmt.Sprintf("Could not delete label %d of organization '%s': %s", state.Id.ValueInt64(), org, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *orgLabelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
+	// Import format: "org/name", where the name may be scoped
+	org, name, ok := strings.Cut(req.ID, "/")
+	if !ok || org == "" || name == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in format 'org/name', got: %s", req.ID),
+		)
+		return
+	}
+
+	labels, err := listAllLabels(func(opt gitea.ListOptions) ([]*gitea.Label, error) {
+		labels, _, err := client.ListOrgLabels(org, gitea.ListOrgLabelsOptions{ListOptions: opt})
+		return labels, err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Label",
+			fmt.Sprintf("Could not list labels of organization '%s': %s", org, err.Error()),
+		)
+		return
+	}
+
+	label := findLabelByName(labels, name)
+	if label == nil {
+		resp.Diagnostics.AddError(
+			"Label Not Found",
+			fmt.Sprintf("Organization '%s' has no label named '%s'.", org, name),
+		)
+		return
+	}
+
+	// Read fills in the remaining attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), label.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("org"), org)...)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccOrgLabelResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccOrgLabelResourceConfig("priority/high", "d93f0b"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_org_label.test", "org", "testlabelorg"),
+					resource.TestCheckResourceAttr("gitea_org_label.test", "name", "priority/high"),
+					resource.TestCheckResourceAttr("gitea_org_label.test", "color", "d93f0b"),
+					resource.TestCheckResourceAttrSet("gitea_org_label.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "gitea_org_label.test",
+				ImportState:       true,
+				ImportStateId:     "testlabelorg/priority/high",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccOrgLabelResourceConfig("priority/urgent", "b60205"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_org_label.test", "name", "priority/urgent"),
+					resource.TestCheckResourceAttr("gitea_org_label.test", "color", "b60205"),
+				),
+			},
+		},
+	})
+}
+
+func testAccOrgLabelResourceConfig(name, color string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "gitea_org" "test" {
+  name       = "testlabelorg"
+  full_name  = "Test Label Organization"
+  visibility = "public"
+}
+
+resource "gitea_org_label" "test" {
+  org         = gitea_org.test.name
+  name        = %[1]q
+  color       = %[2]q
+  description = "Managed by Terraform"
+  exclusive   = true
+}
+`, name, color)
+}
@@ -6,6 +6,7 @@ import (
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -19,6 +20,7 @@ var (
 	_ resource.Resource                = &orgResource{}
 	_ resource.ResourceWithConfigure   = &orgResource{}
 	_ resource.ResourceWithImportState = &orgResource{}
+	_ resource.ResourceWithModifyPlan  = &orgResource{}
 )
 
 func NewOrgResource() resource.Resource {
@@ -26,7 +28,8 @@ func NewOrgResource() resource.Resource {
 }
 
 type orgResource struct {
-	client *gitea.Client
+	client       *gitea.Client
+	providerData *giteaProviderData
 }
 
 type orgResourceModel struct {
@@ -60,10 +63,10 @@ func (r *orgResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 			"name": schema.StringAttribute{
 				Optional:            true,
 				Computed:            true,
-				Description:         "The name of the organization.",
-				MarkdownDescription: "The name of the organization.",
+				Description:         "The name of the organization. Changing it renames the organization, which requires Gitea 1.23 or later.",
+				MarkdownDescription: "The name of the organization. Changing it renames the organization, which requires Gitea 1.23 or later.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 			"username": schema.StringAttribute{
@@ -72,7 +75,7 @@ func (r *orgResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *
 				Description:         "Deprecated alias for name. Use name instead.",
 				MarkdownDescription: "Deprecated alias for `name`. Use `name` instead.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
 				},
 			},
 
@@ -144,21 +147,64 @@ func (r *orgResource) Configure(_ context.Context, req resource.ConfigureRequest
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan plans a changed name as an in-place rename. An omitted name or
+// username follows the configured one, and renaming is gated on the server
+// version.
+func (r *orgResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil || req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var config, state orgResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	newName := orgModelName(config)
+	if newName == "" || newName == orgModelName(state) {
+		return
+	}
+
+	if config.Name.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("name"), newName)...)
+	}
+	if config.Username.IsNull() {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("username"), newName)...)
+	}
+
+	resp.Diagnostics.Append(r.providerData.checkServerVersion("Renaming a gitea_org resource", "1.23.0")...)
+}
+
+// orgModelName returns the organization name of model, taken from name or
+// the deprecated username.
+func orgModelName(model orgResourceModel) string {
+	if name := model.Name.ValueString(); name != "" {
+		return name
+	}
+	return model.Username.ValueString()
 }
 
 // Helper function to get organization repos
 func (r *orgResource) getOrgRepos(ctx context.Context, orgName string) ([]string, error) {
-	repos, _, err := r.client.ListOrgRepos(orgName, gitea.ListOrgReposOptions{
+	client := r.client.WithContext(ctx)
+
+	repos, _, err := client.ListOrgRepos(orgName, gitea.ListOrgReposOptions{
 		ListOptions: gitea.ListOptions{Page: -1},
 	})
 	if err != nil {
@@ -200,6 +246,8 @@ func (r *orgResource) mapOrgToModel(ctx context.Context, org *gitea.Organization
 }
 
 func (r *orgResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan orgResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -235,7 +283,7 @@ func (r *orgResource) Create(ctx context.Context, req resource.CreateRequest, re
 		Website:     plan.Website.ValueString(),
 	}
 
-	org, _, err := r.client.CreateOrg(createOpts)
+	org, _, err := client.CreateOrg(createOpts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Organization",
@@ -256,6 +304,8 @@ func (r *orgResource) Create(ctx context.Context, req resource.CreateRequest, re
 }
 
 func (r *orgResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state orgResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -267,7 +317,7 @@ func (r *orgResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 	if orgName == "" {
 		orgName = state.Username.ValueString()
 	}
-	org, httpResp, err := r.client.GetOrg(orgName)
+	org, httpResp, err := client.GetOrg(orgName)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.State.RemoveResource(ctx)
@@ -292,6 +342,8 @@ func (r *orgResource) Read(ctx context.Context, req resource.ReadRequest, resp *
 }
 
 func (r *orgResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan orgResourceModel
 	var state orgResourceModel
 
@@ -301,9 +353,18 @@ func (r *orgResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		return
 	}
 
-	orgName := state.Name.ValueString()
-	if orgName == "" {
-		orgName = state.Username.ValueString()
+	orgName := orgModelName(state)
+
+	// Rename first so the remaining changes apply under the new name
+	if newName := orgModelName(plan); newName != "" && newName != orgName {
+		if _, err := client.RenameOrg(orgName, gitea.RenameOrgOption{NewName: newName}); err != nil {
+			resp.Diagnostics.AddError(
+				"Error Renaming Organization",
+				fmt.Sprintf("Could not rename organization '%s' to '%s': %s", orgName, newName, err.Error()),
+			)
+			return
+		}
+		orgName = newName
 	}
 
 	editOpts := gitea.EditOrgOption{
@@ -314,7 +375,7 @@ func (r *orgResource) Update(ctx context.Context, req resource.UpdateRequest, re
 		Website:     plan.Website.ValueString(),
 	}
 
-	_, err := r.client.EditOrg(orgName, editOpts)
+	_, err := client.EditOrg(orgName, editOpts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Organization",
@@ -324,7 +385,7 @@ func (r *orgResource) Update(ctx context.Context, req resource.UpdateRequest, re
 	}
 
 	// Read back the organization
-	org, _, err := r.client.GetOrg(orgName)
+	org, _, err := client.GetOrg(orgName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Organization After Update",
@@ -345,6 +406,8 @@ func (r *orgResource) Update(ctx context.Context, req resource.UpdateRequest, re
 }
 
 func (r *orgResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state orgResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -357,7 +420,7 @@ func (r *orgResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 		orgName = state.Username.ValueString()
 	}
 
-	_, err := r.client.DeleteOrg(orgName)
+	_, err := client.DeleteOrg(orgName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Organization",
@@ -368,6 +431,8 @@ func (r *orgResource) Delete(ctx context.Context, req resource.DeleteRequest, re
 }
 
 func (r *orgResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
 	// Import by organization name
 	orgName := req.ID
 
@@ -380,7 +445,7 @@ func (r *orgResource) ImportState(ctx context.Context, req resource.ImportStateR
 	}
 
 	// Fetch the organization
-	org, httpResp, err := r.client.GetOrg(orgName)
+	org, httpResp, err := client.GetOrg(orgName)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.Diagnostics.AddError(
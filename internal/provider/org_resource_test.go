@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
@@ -41,6 +42,19 @@ func TestAccOrgResource(t *testing.T) {
 					resource.TestCheckResourceAttr("gitea_org.test", "full_name", "Updated Test Org"),
 				),
 			},
+			// Rename in place
+			{
+				Config: testAccOrgResourceConfig("testorg-renamed", "Updated Test Org", "public"),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("gitea_org.test", plancheck.ResourceActionUpdate),
+					},
+				},
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_org.test", "username", "testorg-renamed"),
+					resource.TestCheckResourceAttr("gitea_org.test", "name", "testorg-renamed"),
+				),
+			},
 		},
 	})
 }
@@ -1,11 +1,34 @@
 package provider
 
 import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"errors"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
 	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+	"time"
 
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/go-version"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 )
 
 // testAccProtoV6ProviderFactories are used to instantiate a provider during
@@ -22,6 +45,10 @@ func testAccPreCheck(t *testing.T) {
 	if v := os.Getenv("GITEA_HOSTNAME"); v == "" {
 		t.Fatal("GITEA_HOSTNAME must be set for acceptance tests. Expected: http://localhost:3000")
 	}
+	// A token can stand in for the username and password
+	if v := os.Getenv("GITEA_TOKEN"); v != "" {
+		return
+	}
 	if v := os.Getenv("GITEA_USERNAME"); v == "" {
 		t.Fatal("GITEA_USERNAME must be set for acceptance tests. Expected: root")
 	}
@@ -40,3 +67,509 @@ provider "gitea" {
 }
 `
 }
+
+func TestCheckAdminPrivilege_AllowsAdmin(t *testing.T) {
+	data := &giteaProviderData{currentUser: &gitea.User{UserName: "root", IsAdmin: true}}
+	if diags := data.checkAdminPrivilege("gitea_user", "AdminCreateUser"); diags.HasError() {
+		t.Fatalf("expected no error for admin user, got %v", diags)
+	}
+}
+
+func TestCheckAdminPrivilege_RejectsNonAdmin(t *testing.T) {
+	data := &giteaProviderData{currentUser: &gitea.User{UserName: "alice"}}
+	diags := data.checkAdminPrivilege("gitea_user", "AdminCreateUser")
+	if !diags.HasError() {
+		t.Fatal("expected an error for non-admin user")
+	}
+	detail := diags[0].Detail()
+	for _, want := range []string{"gitea_user", "AdminCreateUser", "alice"} {
+		if !strings.Contains(detail, want) {
+			t.Errorf("expected diagnostic detail to mention %q, got: %s", want, detail)
+		}
+	}
+}
+
+func TestSplitSudoImportID(t *testing.T) {
+	cases := []struct {
+		id, sudo, rest string
+	}{
+		{"42", "", "42"},
+		{"svc-bot/42", "svc-bot", "42"},
+		{"", "", ""},
+	}
+	for _, c := range cases {
+		sudo, rest := splitSudoImportID(c.id)
+		if sudo != c.sudo || rest != c.rest {
+			t.Errorf("splitSudoImportID(%q) = (%q, %q), want (%q, %q)", c.id, sudo, rest, c.sudo, c.rest)
+		}
+	}
+}
+
+func TestClientFor_DoesNotLeakSudo(t *testing.T) {
+	var sudoHeaders []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		sudoHeaders = append(sudoHeaders, r.Header.Get("Sudo"))
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write([]byte(`{"login":"root"}`))
+	}))
+	defer server.Close()
+
+	client, err := gitea.NewClient(server.URL, gitea.SetGiteaVersion(""))
+	if err != nil {
+		t.Fatalf("could not create client: %s", err)
+	}
+
+	if _, _, err := clientFor(context.Background(), client, types.StringValue("svc-bot")).GetMyUserInfo(); err != nil {
+		t.Fatalf("impersonated request failed: %s", err)
+	}
+	if _, _, err := clientFor(context.Background(), client, types.StringNull()).GetMyUserInfo(); err != nil {
+		t.Fatalf("plain request failed: %s", err)
+	}
+
+	if len(sudoHeaders) != 2 || sudoHeaders[0] != "svc-bot" || sudoHeaders[1] != "" {
+		t.Fatalf("expected Sudo headers [svc-bot, \"\"], got %q", sudoHeaders)
+	}
+}
+
+// testCertificatePEM returns a self-signed certificate and its private key,
+// both PEM-encoded.
+func testCertificatePEM(t *testing.T) (string, string) {
+	t.Helper()
+
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate key: %s", err)
+	}
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "terraform-provider-gitea test"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("could not create certificate: %s", err)
+	}
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	if err != nil {
+		t.Fatalf("could not marshal key: %s", err)
+	}
+
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER})
+	return string(certPEM), string(keyPEM)
+}
+
+func TestBuildTLSConfig_InlinePEM(t *testing.T) {
+	certPEM, keyPEM := testCertificatePEM(t)
+
+	tlsConfig, diags := buildTLSConfig(&giteaProviderModel{
+		CACertPEM:     types.StringValue(certPEM),
+		ClientCertPEM: types.StringValue(certPEM),
+		ClientKeyPEM:  types.StringValue(keyPEM),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if tlsConfig.RootCAs == nil {
+		t.Error("expected ca_cert_pem to populate RootCAs")
+	}
+	if len(tlsConfig.Certificates) != 1 {
+		t.Errorf("expected one client certificate, got %d", len(tlsConfig.Certificates))
+	}
+}
+
+func TestBuildTLSConfig_ClientCertificateFiles(t *testing.T) {
+	certPEM, keyPEM := testCertificatePEM(t)
+	dir := t.TempDir()
+	certFile := filepath.Join(dir, "client.crt")
+	keyFile := filepath.Join(dir, "client.key")
+	if err := os.WriteFile(certFile, []byte(certPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(keyFile, []byte(keyPEM), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tlsConfig, diags := buildTLSConfig(&giteaProviderModel{
+		ClientCertFile: types.StringValue(certFile),
+		ClientKeyFile:  types.StringValue(keyFile),
+	})
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if len(tlsConfig.Certificates) != 1 {
+		t.Errorf("expected one client certificate, got %d", len(tlsConfig.Certificates))
+	}
+}
+
+func TestBuildTLSConfig_RejectsIncompleteClientCertificate(t *testing.T) {
+	certPEM, _ := testCertificatePEM(t)
+
+	_, diags := buildTLSConfig(&giteaProviderModel{
+		ClientCertPEM: types.StringValue(certPEM),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error for a client certificate without a key")
+	}
+}
+
+func TestBuildTLSConfig_RejectsInvalidCAPEM(t *testing.T) {
+	_, diags := buildTLSConfig(&giteaProviderModel{
+		CACertPEM: types.StringValue("not a certificate"),
+	})
+	if !diags.HasError() {
+		t.Fatal("expected an error for an unparsable ca_cert_pem")
+	}
+}
+
+func TestCheckServerVersion(t *testing.T) {
+	cases := []struct {
+		server  string
+		wantErr bool
+	}{
+		{"1.20.5", true},
+		{"1.21.0", false},
+		{"1.22.3+dev-12-gabcdef", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		data := &giteaProviderData{}
+		if c.server != "" {
+			data.serverVersion = version.Must(version.NewVersion(c.server))
+		}
+
+		diags := data.checkServerVersion("The gitea_repository_actions_secret resource", "1.21.0")
+		if diags.HasError() != c.wantErr {
+			t.Errorf("server %q: expected error=%t, got %v", c.server, c.wantErr, diags)
+		}
+		if c.wantErr && !strings.Contains(diags[0].Detail(), "1.21.0") {
+			t.Errorf("server %q: expected the diagnostic to name the minimum version, got: %s", c.server, diags[0].Detail())
+		}
+	}
+}
+
+func TestClientFor_HonorsContextCancellation(t *testing.T) {
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case <-r.Context().Done():
+		case <-release:
+		}
+	}))
+	defer server.Close()
+	defer close(release)
+
+	client, err := gitea.NewClient(server.URL, gitea.SetGiteaVersion(""))
+	if err != nil {
+		t.Fatalf("could not create client: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	start := time.Now()
+	_, _, err = clientFor(ctx, client, types.StringNull()).GetMyUserInfo()
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("expected the request to be cancelled with the context, got: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed > 5*time.Second {
+		t.Fatalf("expected the request to stop at the deadline, took %s", elapsed)
+	}
+}
+
+// testDefaultOwnerPlan runs applyDefaultOwner for a gitea_repository_key whose
+// configuration sets owner to configured (null when empty) and whose prior
+// state has owner prior (no prior state when empty).
+func testDefaultOwnerPlan(t *testing.T, data *giteaProviderData, configured, prior string) *resource.ModifyPlanResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	NewRepositoryKeyResource().Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+	objectType := s.Type().TerraformType(ctx)
+
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(objectType, nil)}
+	state := tfsdk.State{Schema: s, Raw: tftypes.NewValue(objectType, nil)}
+
+	plan.SetAttribute(ctx, path.Root("repository"), "example")
+	if configured != "" {
+		plan.SetAttribute(ctx, path.Root("owner"), configured)
+	}
+	config := tfsdk.Config{Schema: s, Raw: plan.Raw}
+	if prior != "" {
+		state.SetAttribute(ctx, path.Root("repository"), "example")
+		state.SetAttribute(ctx, path.Root("owner"), prior)
+	}
+
+	req := resource.ModifyPlanRequest{Config: config, Plan: plan, State: state}
+	resp := &resource.ModifyPlanResponse{Plan: plan}
+	data.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+	return resp
+}
+
+func TestApplyDefaultOwner(t *testing.T) {
+	ctx := context.Background()
+	data := &giteaProviderData{defaultOwner: "acme"}
+
+	owner := func(resp *resource.ModifyPlanResponse) string {
+		var value types.String
+		resp.Plan.GetAttribute(ctx, path.Root("owner"), &value)
+		return value.ValueString()
+	}
+
+	resp := testDefaultOwnerPlan(t, data, "", "")
+	if resp.Diagnostics.HasError() || owner(resp) != "acme" {
+		t.Errorf("expected an omitted owner to be planned as the default, got %q: %v", owner(resp), resp.Diagnostics)
+	}
+
+	resp = testDefaultOwnerPlan(t, data, "someone", "")
+	if owner(resp) != "someone" {
+		t.Errorf("expected a configured owner to be kept, got %q", owner(resp))
+	}
+
+	resp = testDefaultOwnerPlan(t, data, "", "acme")
+	if len(resp.RequiresReplace) != 0 {
+		t.Errorf("expected no replacement while the default is unchanged, got %v", resp.RequiresReplace)
+	}
+
+	resp = testDefaultOwnerPlan(t, data, "", "previous")
+	if len(resp.RequiresReplace) != 1 {
+		t.Errorf("expected a changed default to replace the resource, got %v", resp.RequiresReplace)
+	}
+
+	resp = testDefaultOwnerPlan(t, &giteaProviderData{}, "", "")
+	if !resp.Diagnostics.HasError() {
+		t.Error("expected an error when neither owner nor default_owner is set")
+	}
+}
+
+// testRequest is a request recorded by testGiteaServer.
+type testRequest struct {
+	Path   string
+	Header http.Header
+}
+
+// testGiteaServer starts a fake Gitea server that answers the requests made
+// while configuring the provider and records them.
+func testGiteaServer(t *testing.T) (*httptest.Server, *[]testRequest) {
+	t.Helper()
+
+	var requests []testRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests = append(requests, testRequest{Path: r.URL.Path, Header: r.Header.Clone()})
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/api/v1/version":
+			_, _ = w.Write([]byte(`{"version":"1.25.0"}`))
+		case "/api/v1/user":
+			_, _ = w.Write([]byte(`{"login":"alice","is_admin":true}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	t.Cleanup(server.Close)
+	return server, &requests
+}
+
+// testConfigureProvider runs the provider's Configure with only the given
+// attributes set in the provider block. Environment variables that would
+// supply other settings are cleared for the duration of the test.
+func testConfigureProvider(t *testing.T, attributes map[string]string) *provider.ConfigureResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	for _, name := range []string{"GITEA_USERNAME", "GITEA_PASSWORD", "GITEA_TOKEN", "GITEA_HOSTNAME", "GITEA_TOTP_SECRET", "GITEA_TEA_LOGIN"} {
+		t.Setenv(name, "")
+	}
+
+	p := New("test")()
+	var schemaResp provider.SchemaResponse
+	p.Schema(ctx, provider.SchemaRequest{}, &schemaResp)
+	s := schemaResp.Schema
+
+	// A plan is used to build the configuration, which cannot be set directly
+	plan := tfsdk.Plan{Schema: s, Raw: tftypes.NewValue(s.Type().TerraformType(ctx), nil)}
+	for name, value := range attributes {
+		if diags := plan.SetAttribute(ctx, path.Root(name), value); diags.HasError() {
+			t.Fatalf("could not set %s: %v", name, diags)
+		}
+	}
+
+	resp := &provider.ConfigureResponse{}
+	p.Configure(ctx, provider.ConfigureRequest{Config: tfsdk.Config{Schema: s, Raw: plan.Raw}}, resp)
+	return resp
+}
+
+// testSSHKeyPath writes an unencrypted ed25519 private key to a file and returns
+// both the key and the path.
+func testSSHKeyPath(t *testing.T) (string, string) {
+	t.Helper()
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("could not generate key: %s", err)
+	}
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		t.Fatalf("could not marshal key: %s", err)
+	}
+	keyPEM := string(pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}))
+	keyPath := filepath.Join(t.TempDir(), "id_ecdsa")
+	if err := os.WriteFile(keyPath, []byte(keyPEM), 0o600); err != nil {
+		t.Fatalf("could not write key: %s", err)
+	}
+	return keyPEM, keyPath
+}
+
+// testSignedRequests checks that every recorded API request carries an HTTP
+// signature and no token or basic auth credentials. The SDK detects the
+// server version before it can sign, so that request is skipped.
+func testSignedRequests(t *testing.T, requests []testRequest) {
+	t.Helper()
+	signed := 0
+	for _, request := range requests {
+		if request.Path == "/api/v1/version" {
+			continue
+		}
+		signed++
+		header := request.Header
+		if header.Get("Signature") == "" {
+			t.Errorf("expected a signed request, got headers %v", header)
+		}
+		if header.Get("Authorization") != "" {
+			t.Errorf("expected no Authorization header with SSH signature authentication, got %q", header.Get("Authorization"))
+		}
+	}
+	if signed == 0 {
+		t.Fatal("expected API requests to the server")
+	}
+}
+
+func TestSSHSignatureOption_KeyPath(t *testing.T) {
+	server, requests := testGiteaServer(t)
+	_, keyPath := testSSHKeyPath(t)
+
+	data := &giteaProviderModel{
+		SSHAuthType:       types.StringValue(sshAuthTypePubkey),
+		SSHPrivateKeyPath: types.StringValue(keyPath),
+	}
+	client, err := gitea.NewClient(server.URL, sshSignatureOption(data))
+	if err != nil {
+		t.Fatalf("could not create client: %s", err)
+	}
+	if _, _, err := client.GetMyUserInfo(); err != nil {
+		t.Fatalf("request failed: %s", err)
+	}
+
+	testSignedRequests(t, *requests)
+}
+
+func TestSSHSignatureOption_InlineKey(t *testing.T) {
+	server, requests := testGiteaServer(t)
+	keyPEM, _ := testSSHKeyPath(t)
+
+	// The inline key is written to a temporary file, which must not outlive
+	// the client setup
+	tempDir := t.TempDir()
+	t.Setenv("TMPDIR", tempDir)
+
+	data := &giteaProviderModel{
+		SSHAuthType:   types.StringValue(sshAuthTypePubkey),
+		SSHPrivateKey: types.StringValue(keyPEM),
+	}
+	client, err := gitea.NewClient(server.URL, sshSignatureOption(data))
+	if err != nil {
+		t.Fatalf("could not create client: %s", err)
+	}
+	if _, _, err := client.GetMyUserInfo(); err != nil {
+		t.Fatalf("request failed: %s", err)
+	}
+
+	testSignedRequests(t, *requests)
+
+	entries, err := os.ReadDir(tempDir)
+	if err != nil {
+		t.Fatalf("could not list temporary directory: %s", err)
+	}
+	if len(entries) != 0 {
+		t.Errorf("expected the temporary key file to be removed, found %d entries", len(entries))
+	}
+}
+
+func TestSSHSignatureOption_InvalidKey(t *testing.T) {
+	server, _ := testGiteaServer(t)
+
+	data := &giteaProviderModel{
+		SSHAuthType:   types.StringValue(sshAuthTypePubkey),
+		SSHPrivateKey: types.StringValue("not a key"),
+	}
+	if _, err := gitea.NewClient(server.URL, sshSignatureOption(data)); err == nil {
+		t.Fatal("expected an error for an invalid private key")
+	}
+}
+
+func TestConfigure_SSHTakesPrecedence(t *testing.T) {
+	server, requests := testGiteaServer(t)
+	_, keyPath := testSSHKeyPath(t)
+
+	resp := testConfigureProvider(t, map[string]string{
+		"gitea_hostname":       server.URL,
+		"gitea_token":          "unused-token",
+		"gitea_username":       "alice",
+		"gitea_password":       "unused-password",
+		"ssh_auth_type":        sshAuthTypePubkey,
+		"ssh_private_key_path": keyPath,
+	})
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+
+	testSignedRequests(t, *requests)
+}
+
+func TestConfigure_SSHKeyConflicts(t *testing.T) {
+	keyPEM, keyPath := testSSHKeyPath(t)
+
+	cases := []struct {
+		name       string
+		attributes map[string]string
+		summary    string
+	}{
+		{
+			name: "path and inline key",
+			attributes: map[string]string{
+				"ssh_auth_type":        sshAuthTypePubkey,
+				"ssh_private_key_path": keyPath,
+				"ssh_private_key":      keyPEM,
+			},
+			summary: "Conflicting SSH Key Configuration",
+		},
+		{
+			name: "certificate with inline key",
+			attributes: map[string]string{
+				"ssh_auth_type":   sshAuthTypeCertificate,
+				"ssh_private_key": keyPEM,
+			},
+			summary: "Unsupported SSH Key Configuration",
+		},
+	}
+
+	for _, c := range cases {
+		c.attributes["gitea_hostname"] = "http://localhost:3000"
+		resp := testConfigureProvider(t, c.attributes)
+
+		found := false
+		for _, d := range resp.Diagnostics.Errors() {
+			found = found || d.Summary() == c.summary
+		}
+		if !found {
+			t.Errorf("%s: expected a %q error, got: %v", c.name, c.summary, resp.Diagnostics)
+		}
+		if resp.ResourceData != nil {
+			t.Errorf("%s: expected the provider not to be configured", c.name)
+		}
+	}
+}
@@ -18,6 +18,7 @@ var (
 	_ resource.Resource                = &publicKeyResource{}
 	_ resource.ResourceWithConfigure   = &publicKeyResource{}
 	_ resource.ResourceWithImportState = &publicKeyResource{}
+	_ resource.ResourceWithModifyPlan  = &publicKeyResource{}
 )
 
 func NewPublicKeyResource() resource.Resource {
@@ -25,7 +26,8 @@ func NewPublicKeyResource() resource.Resource {
 }
 
 type publicKeyResource struct {
-	client *gitea.Client
+	client       *gitea.Client
+	providerData *giteaProviderData
 }
 
 type publicKeyResourceModel struct {
@@ -121,19 +123,37 @@ func (r *publicKeyResource) Configure(_ context.Context, req resource.ConfigureR
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fails planning early when the authenticated user is not a site
+// administrator, since keys are added and removed through admin endpoints.
+func (r *publicKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	endpoint := "AdminCreateUserPublicKey (POST /admin/users/{username}/keys)"
+	if req.Plan.Raw.IsNull() {
+		endpoint = "AdminDeleteUserPublicKey (DELETE /admin/users/{username}/keys/{id})"
+	}
+
+	resp.Diagnostics.Append(r.providerData.checkAdminPrivilege("gitea_public_key", endpoint)...)
 }
 
 func (r *publicKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan publicKeyResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -149,7 +169,7 @@ func (r *publicKeyResource) Create(ctx context.Context, req resource.CreateReque
 		ReadOnly: plan.ReadOnly.ValueBool(),
 	}
 
-	key, _, err := r.client.AdminCreateUserPublicKey(username, opt)
+	key, _, err := client.AdminCreateUserPublicKey(username, opt)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Public Key",
@@ -170,6 +190,8 @@ func (r *publicKeyResource) Create(ctx context.Context, req resource.CreateReque
 }
 
 func (r *publicKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state publicKeyResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -189,7 +211,7 @@ func (r *publicKeyResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	// List keys for the user and find the matching one
 	username := state.Username.ValueString()
-	keys, _, err := r.client.ListPublicKeys(username, gitea.ListPublicKeysOptions{
+	keys, _, err := client.ListPublicKeys(username, gitea.ListPublicKeysOptions{
 		ListOptions: gitea.ListOptions{Page: -1},
 	})
 	if err != nil {
@@ -234,6 +256,8 @@ func (r *publicKeyResource) Update(ctx context.Context, req resource.UpdateReque
 }
 
 func (r *publicKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state publicKeyResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -252,7 +276,7 @@ func (r *publicKeyResource) Delete(ctx context.Context, req resource.DeleteReque
 	}
 
 	username := state.Username.ValueString()
-	_, err = r.client.AdminDeleteUserPublicKey(username, int(keyID))
+	_, err = client.AdminDeleteUserPublicKey(username, int(keyID))
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Public Key",
@@ -263,6 +287,8 @@ func (r *publicKeyResource) Delete(ctx context.Context, req resource.DeleteReque
 }
 
 func (r *publicKeyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
 	// Import format: "username/key_id"
 	id := req.ID
 	parts := strings.Split(id, "/")
@@ -287,7 +313,7 @@ func (r *publicKeyResource) ImportState(ctx context.Context, req resource.Import
 	}
 
 	// List keys for the user and find the matching one
-	keys, _, err := r.client.ListPublicKeys(username, gitea.ListPublicKeysOptions{
+	keys, _, err := client.ListPublicKeys(username, gitea.ListPublicKeysOptions{
 		ListOptions: gitea.ListOptions{Page: -1},
 	})
 	if err != nil {
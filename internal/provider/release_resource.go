@@ -0,0 +1,672 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &releaseResource{}
+	_ resource.ResourceWithConfigure   = &releaseResource{}
+	_ resource.ResourceWithImportState = &releaseResource{}
+	_ resource.ResourceWithModifyPlan  = &releaseResource{}
+)
+
+func NewReleaseResource() resource.Resource {
+	return &releaseResource{}
+}
+
+type releaseResource struct {
+	client       *gitea.Client
+	providerData *giteaProviderData
+}
+
+type releaseResourceModel struct {
+	// Required
+	Repository types.String `tfsdk:"repository"`
+	TagName    types.String `tfsdk:"tag_name"`
+	Title      types.String `tfsdk:"title"`
+
+	// Optional
+	Owner           types.String        `tfsdk:"owner"`
+	TargetCommitish types.String        `tfsdk:"target_commitish"`
+	Note            types.String        `tfsdk:"note"`
+	Draft           types.Bool          `tfsdk:"draft"`
+	Prerelease      types.Bool          `tfsdk:"prerelease"`
+	Assets          []releaseAssetModel `tfsdk:"assets"`
+
+	// Computed
+	Id         types.Int64  `tfsdk:"id"`
+	HtmlUrl    types.String `tfsdk:"html_url"`
+	TarballUrl types.String `tfsdk:"tarball_url"`
+	ZipballUrl types.String `tfsdk:"zipball_url"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+}
+
+type releaseAssetModel struct {
+	Source        types.String `tfsdk:"source"`
+	Name          types.String `tfsdk:"name"`
+	ContentSha256 types.String `tfsdk:"content_sha256"`
+	Id            types.Int64  `tfsdk:"id"`
+	Size          types.Int64  `tfsdk:"size"`
+	DownloadUrl   types.String `tfsdk:"download_url"`
+}
+
+func (r *releaseResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_release"
+}
+
+func (r *releaseResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a release of a Gitea repository and the assets attached to it.",
+		MarkdownDescription: "Manages a release of a Gitea repository and the assets attached to it. Assets are uploaded from local files and re-uploaded whenever the content of a file changes. Deleting the release keeps its tag.",
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the repository.",
+				MarkdownDescription: "Name of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"tag_name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the tag of the release. The tag is created from target_commitish when it does not exist yet.",
+				MarkdownDescription: "Name of the tag of the release. The tag is created from `target_commitish` when it does not exist yet.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:            true,
+				Description:         "Title of the release.",
+				MarkdownDescription: "Title of the release.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Optional
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Owner of the repository. Defaults to the provider's default_owner.",
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"target_commitish": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Branch or commit SHA the tag is created from. Defaults to the default branch of the repository.",
+				MarkdownDescription: "Branch or commit SHA the tag is created from. Defaults to the default branch of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"note": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "Release notes, in Markdown.",
+				MarkdownDescription: "Release notes, in Markdown.",
+			},
+			"draft": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether the release is a draft. Defaults to false.",
+				MarkdownDescription: "Whether the release is a draft. Defaults to `false`.",
+			},
+			"prerelease": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether the release is marked as a pre-release. Defaults to false.",
+				MarkdownDescription: "Whether the release is marked as a pre-release. Defaults to `false`.",
+			},
+			"assets": schema.ListNestedAttribute{
+				Optional:            true,
+				Description:         "Files attached to the release. Assets are matched by name, assets uploaded outside of Terraform are left alone.",
+				MarkdownDescription: "Files attached to the release. Assets are matched by name, assets uploaded outside of Terraform are left alone.",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"source": schema.StringAttribute{
+							Required:            true,
+							Description:         "Path of the local file to upload.",
+							MarkdownDescription: "Path of the local file to upload.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"name": schema.StringAttribute{
+							Optional:            true,
+							Computed:            true,
+							Description:         "Name of the asset. Defaults to the file name of source.",
+							MarkdownDescription: "Name of the asset. Defaults to the file name of `source`.",
+							Validators: []validator.String{
+								stringvalidator.LengthAtLeast(1),
+							},
+						},
+						"content_sha256": schema.StringAttribute{
+							Computed:            true,
+							Description:         "SHA-256 hash of the uploaded file. A change of the local file replaces the asset.",
+							MarkdownDescription: "SHA-256 hash of the uploaded file. A change of the local file replaces the asset.",
+						},
+						"id": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "The ID of the asset.",
+							MarkdownDescription: "The ID of the asset.",
+						},
+						"size": schema.Int64Attribute{
+							Computed:            true,
+							Description:         "Size of the asset in bytes.",
+							MarkdownDescription: "Size of the asset in bytes.",
+						},
+						"download_url": schema.StringAttribute{
+							Computed:            true,
+							Description:         "URL to download the asset.",
+							MarkdownDescription: "URL to download the asset.",
+						},
+					},
+				},
+			},
+
+			// Computed
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The ID of the release.",
+				MarkdownDescription: "The ID of the release.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"html_url": schema.StringAttribute{
+				Computed:            true,
+				Description:         "URL of the release page.",
+				MarkdownDescription: "URL of the release page.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tarball_url": schema.StringAttribute{
+				Computed:            true,
+				Description:         "URL of the source code archive in tar.gz format.",
+				MarkdownDescription: "URL of the source code archive in tar.gz format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zipball_url": schema.StringAttribute{
+				Computed:            true,
+				Description:         "URL of the source code archive in zip format.",
+				MarkdownDescription: "URL of the source code archive in zip format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Timestamp when the release was created.",
+				MarkdownDescription: "Timestamp when the release was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *releaseResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*giteaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner, marks the
+// URLs unknown when the tag changes and hashes the local asset files, so a
+// changed file shows up in the plan.
+func (r *releaseResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The URLs are derived from the tag, so they only change along with it
+	if !req.State.Raw.IsNull() {
+		var plannedTag, priorTag types.String
+		resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("tag_name"), &plannedTag)...)
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("tag_name"), &priorTag)...)
+		if !plannedTag.Equal(priorTag) {
+			for _, name := range []string{"html_url", "tarball_url", "zipball_url"} {
+				resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root(name), types.StringUnknown())...)
+			}
+		}
+	}
+
+	var planned types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("assets"), &planned)...)
+	if resp.Diagnostics.HasError() || planned.IsNull() || planned.IsUnknown() {
+		return
+	}
+
+	var assets, prior []releaseAssetModel
+	resp.Diagnostics.Append(planned.ElementsAs(ctx, &assets, false)...)
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("assets"), &prior)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, err := range planReleaseAssets(assets, prior) {
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("assets").AtListIndex(i),
+				"Invalid Release Asset",
+				err.Error(),
+			)
+		}
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("assets"), assets)...)
+}
+
+func (r *releaseResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan releaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+
+	release, _, err := client.CreateRelease(owner, repo, gitea.CreateReleaseOption{
+		TagName:      plan.TagName.ValueString(),
+		Target:       plan.TargetCommitish.ValueString(),
+		Title:        plan.Title.ValueString(),
+		Note:         plan.Note.ValueString(),
+		IsDraft:      plan.Draft.ValueBool(),
+		IsPrerelease: plan.Prerelease.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Release",
+			fmt.Sprintf("Could not create release '%s' in repository '%s/%s': %s", plan.TagName.ValueString(), owner, repo, err.Error()),
+		)
+		return
+	}
+
+	setReleaseComputed(&plan, release)
+
+	// The release exists from here on, so it is saved even when an upload fails
+	var uploadErr error
+	if plan.Assets != nil {
+		plan.Assets, uploadErr = r.syncReleaseAssets(client, owner, repo, release.ID, plan.Assets, nil)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if uploadErr != nil {
+		resp.Diagnostics.AddError(
+			"Error Uploading Release Asset",
+			fmt.Sprintf("Could not upload the assets of release '%s' in repository '%s/%s': %s", plan.TagName.ValueString(), owner, repo, uploadErr.Error()),
+		)
+	}
+}
+
+func (r *releaseResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state releaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+
+	release, httpResp, err := client.GetRelease(owner, repo, state.Id.ValueInt64())
+	if err != nil {
+		// Handle 404 - release was deleted outside of Terraform
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Release",
+			fmt.Sprintf("Could not read release %d of repository '%s/%s': %s", state.Id.ValueInt64(), owner, repo, err.Error()),
+		)
+		return
+	}
+
+	state.TagName = types.StringValue(release.TagName)
+	state.Title = types.StringValue(release.Title)
+	state.Note = types.StringValue(release.Note)
+	state.Draft = types.BoolValue(release.IsDraft)
+	state.Prerelease = types.BoolValue(release.IsPrerelease)
+	setReleaseComputed(&state, release)
+
+	// Assets deleted outside of Terraform drop out of the state and are
+	// uploaded again by the next apply
+	if state.Assets != nil {
+		attachments := make(map[int64]*gitea.Attachment, len(release.Attachments))
+		for _, attachment := range release.Attachments {
+			attachments[attachment.ID] = attachment
+		}
+
+		assets := make([]releaseAssetModel, 0, len(state.Assets))
+		for _, asset := range state.Assets {
+			attachment, ok := attachments[asset.Id.ValueInt64()]
+			if !ok {
+				continue
+			}
+			setReleaseAssetComputed(&asset, attachment)
+			assets = append(assets, asset)
+		}
+		state.Assets = assets
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *releaseResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan, state releaseResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+
+	release, _, err := client.EditRelease(owner, repo, state.Id.ValueInt64(), gitea.EditReleaseOption{
+		TagName:      plan.TagName.ValueString(),
+		Target:       plan.TargetCommitish.ValueString(),
+		Title:        plan.Title.ValueString(),
+		Note:         plan.Note.ValueString(),
+		IsDraft:      plan.Draft.ValueBoolPointer(),
+		IsPrerelease: plan.Prerelease.ValueBoolPointer(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Release",
+			fmt.Sprintf("Could not update release %d of repository '%s/%s': %s", state.Id.ValueInt64(), owner, repo, err.Error()),
+		)
+		return
+	}
+
+	setReleaseComputed(&plan, release)
+
+	assets, err := r.syncReleaseAssets(client, owner, repo, release.ID, plan.Assets, state.Assets)
+	if plan.Assets != nil {
+		plan.Assets = assets
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Release Assets",
+			fmt.Sprintf("Could not update the assets of release %d of repository '%s/%s': %s", state.Id.ValueInt64(), owner, repo, err.Error()),
+		)
+	}
+}
+
+func (r *releaseResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state releaseResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+
+	// Deleting the release removes its assets as well
+	httpResp, err := client.DeleteRelease(owner, repo, state.Id.ValueInt64())
+	if err != nil {
+		// If already deleted (404), consider it a success
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Release",
+			fmt.Sprintf("Could not delete release %d of repository '%s/%s': %s", state.Id.ValueInt64(), owner, repo, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *releaseResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
+	// Import format: "owner/repository/tag", where the tag may contain slashes
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in format 'owner/repository/tag', got: %s", req.ID),
+		)
+		return
+	}
+
+	owner, repo, tag := parts[0], parts[1], parts[2]
+
+	release, httpResp, err := client.GetReleaseByTag(owner, repo, tag)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.Diagnostics.AddError(
+				"Release Not Found",
+				fmt.Sprintf("Repository '%s/%s' has no release for tag '%s'.", owner, repo, tag),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Importing Release",
+			fmt.Sprintf("Could not read release '%s' of repository '%s/%s': %s", tag, owner, repo, err.Error()),
+		)
+		return
+	}
+
+	// Read fills in the remaining attributes. Existing assets are not imported,
+	// they stay unmanaged until assets is configured.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), release.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), owner)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repo)...)
+}
+
+// syncReleaseAssets deletes the prior assets that are no longer planned or
+// whose content changed, then uploads the planned assets that are missing. It
+// returns the assets attached to the release, which on error are the ones
+// handled so far.
+func (r *releaseResource) syncReleaseAssets(client *gitea.Client, owner, repo string, releaseID int64, planned, prior []releaseAssetModel) ([]releaseAssetModel, error) {
+	current := make(map[string]releaseAssetModel, len(prior))
+	for _, asset := range prior {
+		current[asset.Name.ValueString()] = asset
+	}
+
+	for _, asset := range prior {
+		if kept, ok := findReleaseAsset(planned, asset.Name.ValueString()); ok && kept.ContentSha256.Equal(asset.ContentSha256) {
+			continue
+		}
+		httpResp, err := client.DeleteReleaseAttachment(owner, repo, releaseID, asset.Id.ValueInt64())
+		if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
+			return releaseAssetsOf(planned, current), fmt.Errorf("could not delete asset '%s': %w", asset.Name.ValueString(), err)
+		}
+		delete(current, asset.Name.ValueString())
+	}
+
+	for _, asset := range planned {
+		if _, ok := current[asset.Name.ValueString()]; ok {
+			continue
+		}
+
+		content, sha, err := readReleaseAsset(asset.Source.ValueString())
+		if err != nil {
+			return releaseAssetsOf(planned, current), err
+		}
+		if !asset.ContentSha256.IsUnknown() && asset.ContentSha256.ValueString() != sha {
+			return releaseAssetsOf(planned, current), fmt.Errorf("file '%s' changed after the plan was made, run terraform apply again", asset.Source.ValueString())
+		}
+
+		attachment, _, err := client.CreateReleaseAttachment(owner, repo, releaseID, bytes.NewReader(content), asset.Name.ValueString())
+		if err != nil {
+			return releaseAssetsOf(planned, current), fmt.Errorf("could not upload asset '%s': %w", asset.Name.ValueString(), err)
+		}
+
+		asset.ContentSha256 = types.StringValue(sha)
+		setReleaseAssetComputed(&asset, attachment)
+		current[asset.Name.ValueString()] = asset
+	}
+
+	return releaseAssetsOf(planned, current), nil
+}
+
+// planReleaseAssets fills in the name and content hash of each planned asset.
+// Assets whose file is unchanged keep the attributes of the prior asset, the
+// others are planned to be uploaded again. The returned errors are indexed
+// like assets.
+func planReleaseAssets(assets, prior []releaseAssetModel) []error {
+	errs := make([]error, len(assets))
+	names := make(map[string]bool, len(assets))
+
+	for i := range assets {
+		asset := &assets[i]
+		if asset.Source.IsUnknown() {
+			continue
+		}
+
+		if asset.Name.IsNull() || asset.Name.IsUnknown() {
+			asset.Name = types.StringValue(filepath.Base(asset.Source.ValueString()))
+		}
+		if names[asset.Name.ValueString()] {
+			errs[i] = fmt.Errorf("more than one asset is named '%s'", asset.Name.ValueString())
+			continue
+		}
+		names[asset.Name.ValueString()] = true
+
+		_, sha, err := readReleaseAsset(asset.Source.ValueString())
+		if err != nil {
+			errs[i] = err
+			continue
+		}
+		asset.ContentSha256 = types.StringValue(sha)
+
+		if existing, ok := findReleaseAsset(prior, asset.Name.ValueString()); ok && existing.ContentSha256.Equal(asset.ContentSha256) {
+			asset.Id = existing.Id
+			asset.Size = existing.Size
+			asset.DownloadUrl = existing.DownloadUrl
+			continue
+		}
+		asset.Id = types.Int64Unknown()
+		asset.Size = types.Int64Unknown()
+		asset.DownloadUrl = types.StringUnknown()
+	}
+
+	return errs
+}
+
+// readReleaseAsset reads the file at source and returns its content and
+// SHA-256 hash.
+func readReleaseAsset(source string) ([]byte, string, error) {
+	content, err := os.ReadFile(source)
+	if err != nil {
+		return nil, "", fmt.Errorf("could not read asset file: %w", err)
+	}
+	sum := sha256.Sum256(content)
+	return content, hex.EncodeToString(sum[:]), nil
+}
+
+// findReleaseAsset returns the asset with the given name.
+func findReleaseAsset(assets []releaseAssetModel, name string) (releaseAssetModel, bool) {
+	for _, asset := range assets {
+		if asset.Name.ValueString() == name {
+			return asset, true
+		}
+	}
+	return releaseAssetModel{}, false
+}
+
+// releaseAssetsOf returns the assets of current in the order they are planned.
+func releaseAssetsOf(planned []releaseAssetModel, current map[string]releaseAssetModel) []releaseAssetModel {
+	assets := make([]releaseAssetModel, 0, len(current))
+	for _, asset := range planned {
+		if uploaded, ok := current[asset.Name.ValueString()]; ok {
+			uploaded.Source = asset.Source
+			assets = append(assets, uploaded)
+		}
+	}
+	return assets
+}
+
+// setReleaseComputed copies the attributes Gitea computes from release.
+func setReleaseComputed(model *releaseResourceModel, release *gitea.Release) {
+	model.Id = types.Int64Value(release.ID)
+	model.TargetCommitish = types.StringValue(release.Target)
+	model.HtmlUrl = types.StringValue(release.HTMLURL)
+	model.TarballUrl = types.StringValue(release.TarURL)
+	model.ZipballUrl = types.StringValue(release.ZipURL)
+	model.CreatedAt = types.StringValue(release.CreatedAt.Format("2006-01-02T15:04:05Z07:00"))
+}
+
+// setReleaseAssetComputed copies the attributes Gitea computes from attachment.
+func setReleaseAssetComputed(model *releaseAssetModel, attachment *gitea.Attachment) {
+	model.Name = types.StringValue(attachment.Name)
+	model.Id = types.Int64Value(attachment.ID)
+	model.Size = types.Int64Value(attachment.Size)
+	model.DownloadUrl = types.StringValue(attachment.DownloadURL)
+}
@@ -0,0 +1,169 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPlanReleaseAssets(t *testing.T) {
+	dir := t.TempDir()
+	binary := filepath.Join(dir, "app-linux-amd64")
+	checksums := filepath.Join(dir, "checksums.txt")
+	if err := os.WriteFile(binary, []byte("binary"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(checksums, []byte("checksums"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	_, binarySha, err := readReleaseAsset(binary)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	prior := []releaseAssetModel{
+		{
+			Name:          types.StringValue("app-linux-amd64"),
+			ContentSha256: types.StringValue(binarySha),
+			Id:            types.Int64Value(1),
+			Size:          types.Int64Value(6),
+			DownloadUrl:   types.StringValue("https://gitea.example.com/attachments/1"),
+		},
+		{
+			Name:          types.StringValue("SHA256SUMS"),
+			ContentSha256: types.StringValue("outdated"),
+			Id:            types.Int64Value(2),
+			Size:          types.Int64Value(8),
+			DownloadUrl:   types.StringValue("https://gitea.example.com/attachments/2"),
+		},
+	}
+	assets := []releaseAssetModel{
+		{Source: types.StringValue(binary), Name: types.StringUnknown()},
+		{Source: types.StringValue(checksums), Name: types.StringValue("SHA256SUMS")},
+	}
+
+	for _, err := range planReleaseAssets(assets, prior) {
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+	}
+
+	if assets[0].Name.ValueString() != "app-linux-amd64" {
+		t.Errorf("expected the name to default to the file name, got %s", assets[0].Name)
+	}
+	if assets[0].Id.ValueInt64() != 1 || assets[0].ContentSha256.ValueString() != binarySha {
+		t.Errorf("expected the unchanged asset to keep its prior attributes, got %+v", assets[0])
+	}
+	if !assets[1].Id.IsUnknown() || !assets[1].DownloadUrl.IsUnknown() {
+		t.Errorf("expected the changed asset to be uploaded again, got %+v", assets[1])
+	}
+}
+
+func TestPlanReleaseAssets_Errors(t *testing.T) {
+	dir := t.TempDir()
+	source := filepath.Join(dir, "app.tar.gz")
+	if err := os.WriteFile(source, []byte("archive"), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	assets := []releaseAssetModel{
+		{Source: types.StringValue(source), Name: types.StringNull()},
+		{Source: types.StringValue(source), Name: types.StringValue("app.tar.gz")},
+		{Source: types.StringValue(filepath.Join(dir, "missing")), Name: types.StringNull()},
+	}
+
+	errs := planReleaseAssets(assets, nil)
+	if errs[0] != nil {
+		t.Errorf("unexpected error for the first asset: %s", errs[0])
+	}
+	if errs[1] == nil {
+		t.Error("expected an error for a duplicate asset name")
+	}
+	if errs[2] == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
+
+func TestAccReleaseResource(t *testing.T) {
+	source := filepath.Join(t.TempDir(), "notes.txt")
+	writeAsset := func(content string) {
+		if err := os.WriteFile(source, []byte(content), 0o600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeAsset("first build")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccReleaseResourceConfig("v1.0.0", "First release", false, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_release.test", "tag_name", "v1.0.0"),
+					resource.TestCheckResourceAttr("gitea_release.test", "title", "First release"),
+					resource.TestCheckResourceAttr("gitea_release.test", "target_commitish", "main"),
+					resource.TestCheckResourceAttr("gitea_release.test", "prerelease", "false"),
+					resource.TestCheckResourceAttr("gitea_release.test", "assets.#", "1"),
+					resource.TestCheckResourceAttr("gitea_release.test", "assets.0.name", "notes.txt"),
+					resource.TestCheckResourceAttr("gitea_release.test", "assets.0.size", "11"),
+					resource.TestCheckResourceAttrSet("gitea_release.test", "assets.0.download_url"),
+					resource.TestCheckResourceAttrSet("gitea_release.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "gitea_release.test",
+				ImportState:             true,
+				ImportStateId:           "root/test-repo-releases/v1.0.0",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"assets"},
+			},
+			// Update and Read testing: a changed file is uploaded again
+			{
+				PreConfig: func() { writeAsset("second build") },
+				Config:    testAccReleaseResourceConfig("v1.0.0", "First release candidate", true, source),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_release.test", "title", "First release candidate"),
+					resource.TestCheckResourceAttr("gitea_release.test", "prerelease", "true"),
+					resource.TestCheckResourceAttr("gitea_release.test", "assets.#", "1"),
+					resource.TestCheckResourceAttr("gitea_release.test", "assets.0.size", "12"),
+				),
+			},
+		},
+	})
+}
+
+func testAccReleaseResourceConfig(tag, title string, prerelease bool, source string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "gitea_repository" "test" {
+  username       = "root"
+  name           = "test-repo-releases"
+  private        = false
+  auto_init      = true
+  default_branch = "main"
+}
+
+resource "gitea_release" "test" {
+  owner            = gitea_repository.test.username
+  repository       = gitea_repository.test.name
+  tag_name         = %[1]q
+  target_commitish = "main"
+  title            = %[2]q
+  note             = "Release notes"
+  prerelease       = %[3]t
+
+  assets = [
+    {
+      source = %[4]q
+    },
+  ]
+}
+`, tag, title, prerelease, source)
+}
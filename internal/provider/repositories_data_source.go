@@ -123,19 +123,21 @@ func (d *repositoriesDataSource) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
 }
 
 func (d *repositoriesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	client := d.client.WithContext(ctx)
+
 	var data repositoriesDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -168,23 +170,23 @@ func (d *repositoriesDataSource) Read(ctx context.Context, req datasource.ReadRe
 
 	if !data.Username.IsNull() {
 		// List repos for a specific user
-		repos, _, err = d.client.ListUserRepos(data.Username.ValueString(), gitea.ListReposOptions{
+		repos, _, err = client.ListUserRepos(data.Username.ValueString(), gitea.ListReposOptions{
 			ListOptions: gitea.ListOptions{Page: -1},
 		})
 	} else if !data.Org.IsNull() {
 		// List repos for an organization
-		repos, _, err = d.client.ListOrgRepos(data.Org.ValueString(), gitea.ListOrgReposOptions{
+		repos, _, err = client.ListOrgRepos(data.Org.ValueString(), gitea.ListOrgReposOptions{
 			ListOptions: gitea.ListOptions{Page: -1},
 		})
 	} else if !data.Search.IsNull() {
 		// Search for repos
-		repos, _, err = d.client.SearchRepos(gitea.SearchRepoOptions{
+		repos, _, err = client.SearchRepos(gitea.SearchRepoOptions{
 			Keyword:     data.Search.ValueString(),
 			ListOptions: gitea.ListOptions{Page: -1},
 		})
 	} else {
 		// List all repos for the authenticated user
-		repos, _, err = d.client.ListMyRepos(gitea.ListReposOptions{
+		repos, _, err = client.ListMyRepos(gitea.ListReposOptions{
 			ListOptions: gitea.ListOptions{Page: -1},
 		})
 	}
@@ -17,13 +17,15 @@ import (
 var _ resource.Resource = (*repositoryActionsSecretResource)(nil)
 var _ resource.ResourceWithConfigure = (*repositoryActionsSecretResource)(nil)
 var _ resource.ResourceWithImportState = (*repositoryActionsSecretResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*repositoryActionsSecretResource)(nil)
 
 func NewRepositoryActionsSecretResource() resource.Resource {
 	return &repositoryActionsSecretResource{}
 }
 
 type repositoryActionsSecretResource struct {
-	client *gitea.Client
+	client       *gitea.Client
+	providerData *giteaProviderData
 }
 
 type repositoryActionsSecretResourceModel struct {
@@ -46,13 +48,14 @@ func (r *repositoryActionsSecretResource) Metadata(ctx context.Context, req reso
 
 func (r *repositoryActionsSecretResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a repository actions secret",
+		MarkdownDescription: "Manages a repository actions secret. Requires Gitea 1.21 or later",
 		Attributes: map[string]schema.Attribute{
 			"owner": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Owner of the repository",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"repository": schema.StringAttribute{
@@ -91,19 +94,34 @@ func (r *repositoryActionsSecretResource) Configure(ctx context.Context, req res
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner and
+// fails planning early on servers that predate the repository actions secrets API.
+func (r *repositoryActionsSecretResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+
+	resp.Diagnostics.Append(r.providerData.checkServerVersion("The gitea_repository_actions_secret resource", "1.21.0")...)
 }
 
 func (r *repositoryActionsSecretResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryActionsSecretResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -117,14 +135,14 @@ func (r *repositoryActionsSecretResource) Create(ctx context.Context, req resour
 		Description: data.Description.ValueString(),
 	}
 
-	_, err := r.client.CreateRepoActionSecret(data.Owner.ValueString(), data.Repo.ValueString(), opt)
+	_, err := client.CreateRepoActionSecret(data.Owner.ValueString(), data.Repo.ValueString(), opt)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create repository actions secret, got error: %s", err))
 		return
 	}
 
 	// Read back to get created timestamp
-	secrets, _, err := r.client.ListRepoActionSecret(data.Owner.ValueString(), data.Repo.ValueString(), gitea.ListRepoActionSecretOption{})
+	secrets, _, err := client.ListRepoActionSecret(data.Owner.ValueString(), data.Repo.ValueString(), gitea.ListRepoActionSecretOption{})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read created secret, got error: %s", err))
 		return
@@ -144,6 +162,8 @@ func (r *repositoryActionsSecretResource) Create(ctx context.Context, req resour
 }
 
 func (r *repositoryActionsSecretResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryActionsSecretResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -151,7 +171,7 @@ func (r *repositoryActionsSecretResource) Read(ctx context.Context, req resource
 		return
 	}
 
-	secrets, _, err := r.client.ListRepoActionSecret(data.Owner.ValueString(), data.Repo.ValueString(), gitea.ListRepoActionSecretOption{})
+	secrets, _, err := client.ListRepoActionSecret(data.Owner.ValueString(), data.Repo.ValueString(), gitea.ListRepoActionSecretOption{})
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read repository actions secrets, got error: %s", err))
 		return
@@ -181,6 +201,8 @@ func (r *repositoryActionsSecretResource) Read(ctx context.Context, req resource
 }
 
 func (r *repositoryActionsSecretResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryActionsSecretResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -195,7 +217,7 @@ func (r *repositoryActionsSecretResource) Update(ctx context.Context, req resour
 		Description: data.Description.ValueString(),
 	}
 
-	_, err := r.client.CreateRepoActionSecret(data.Owner.ValueString(), data.Repo.ValueString(), opt)
+	_, err := client.CreateRepoActionSecret(data.Owner.ValueString(), data.Repo.ValueString(), opt)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update repository actions secret, got error: %s", err))
 		return
@@ -205,6 +227,8 @@ func (r *repositoryActionsSecretResource) Update(ctx context.Context, req resour
 }
 
 func (r *repositoryActionsSecretResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryActionsSecretResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -212,7 +236,7 @@ func (r *repositoryActionsSecretResource) Delete(ctx context.Context, req resour
 		return
 	}
 
-	_, err := r.client.DeleteRepoActionSecret(data.Owner.ValueString(), data.Repo.ValueString(), data.Name.ValueString())
+	_, err := client.DeleteRepoActionSecret(data.Owner.ValueString(), data.Repo.ValueString(), data.Name.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete repository actions secret, got error: %s", err))
 		return
@@ -17,13 +17,15 @@ import (
 var _ resource.Resource = (*repositoryActionsVariableResource)(nil)
 var _ resource.ResourceWithConfigure = (*repositoryActionsVariableResource)(nil)
 var _ resource.ResourceWithImportState = (*repositoryActionsVariableResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*repositoryActionsVariableResource)(nil)
 
 func NewRepositoryActionsVariableResource() resource.Resource {
 	return &repositoryActionsVariableResource{}
 }
 
 type repositoryActionsVariableResource struct {
-	client *gitea.Client
+	client       *gitea.Client
+	providerData *giteaProviderData
 }
 
 type repositoryActionsVariableResourceModel struct {
@@ -40,13 +42,14 @@ func (r *repositoryActionsVariableResource) Metadata(ctx context.Context, req re
 
 func (r *repositoryActionsVariableResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages a repository actions variable",
+		MarkdownDescription: "Manages a repository actions variable. Requires Gitea 1.22 or later",
 		Attributes: map[string]schema.Attribute{
 			"owner": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Owner of the repository",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"repository": schema.StringAttribute{
@@ -76,16 +79,29 @@ func (r *repositoryActionsVariableResource) Configure(ctx context.Context, req r
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner and
+// fails planning early on servers that predate the actions variables API.
+func (r *repositoryActionsVariableResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+
+	resp.Diagnostics.Append(r.providerData.checkServerVersion("The gitea_repository_actions_variable resource", "1.22.0")...)
 }
 
 func (r *repositoryActionsVariableResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -96,12 +112,27 @@ func (r *repositoryActionsVariableResource) Create(ctx context.Context, req reso
 		return
 	}
 
-	_, err := r.client.CreateRepoActionVariable(
+	// The variable name is part of the request path, so a repeated request
+	// cannot create a second variable
+	ctx = markRetrySafe(ctx)
+	httpResp, err := r.client.WithContext(ctx).CreateRepoActionVariable(
 		data.Owner.ValueString(),
 		data.Repo.ValueString(),
 		data.Name.ValueString(),
 		data.Value.ValueString(),
 	)
+	// A conflict after a retry may come from the earlier attempt succeeding
+	// with its response lost, which the variable's value confirms
+	if err != nil && httpResp != nil && httpResp.StatusCode == 409 && wasRetried(ctx) {
+		variable, _, readErr := r.client.WithContext(ctx).GetRepoActionVariable(
+			data.Owner.ValueString(),
+			data.Repo.ValueString(),
+			data.Name.ValueString(),
+		)
+		if readErr == nil && variable.Value == data.Value.ValueString() {
+			err = nil
+		}
+	}
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create repository actions variable, got error: %s", err))
 		return
@@ -111,6 +142,8 @@ func (r *repositoryActionsVariableResource) Create(ctx context.Context, req reso
 }
 
 func (r *repositoryActionsVariableResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryActionsVariableResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -118,7 +151,7 @@ func (r *repositoryActionsVariableResource) Read(ctx context.Context, req resour
 		return
 	}
 
-	variable, httpResp, err := r.client.GetRepoActionVariable(
+	variable, httpResp, err := client.GetRepoActionVariable(
 		data.Owner.ValueString(),
 		data.Repo.ValueString(),
 		data.Name.ValueString(),
@@ -139,6 +172,8 @@ func (r *repositoryActionsVariableResource) Read(ctx context.Context, req resour
 }
 
 func (r *repositoryActionsVariableResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryActionsVariableResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -146,7 +181,7 @@ func (r *repositoryActionsVariableResource) Update(ctx context.Context, req reso
 		return
 	}
 
-	_, err := r.client.UpdateRepoActionVariable(
+	_, err := client.UpdateRepoActionVariable(
 		data.Owner.ValueString(),
 		data.Repo.ValueString(),
 		data.Name.ValueString(),
@@ -161,6 +196,8 @@ func (r *repositoryActionsVariableResource) Update(ctx context.Context, req reso
 }
 
 func (r *repositoryActionsVariableResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryActionsVariableResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -168,7 +205,7 @@ func (r *repositoryActionsVariableResource) Delete(ctx context.Context, req reso
 		return
 	}
 
-	_, err := r.client.DeleteRepoActionVariable(
+	_, err := client.DeleteRepoActionVariable(
 		data.Owner.ValueString(),
 		data.Repo.ValueString(),
 		data.Name.ValueString(),
@@ -0,0 +1,347 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &repositoryCollaboratorResource{}
+	_ resource.ResourceWithConfigure   = &repositoryCollaboratorResource{}
+	_ resource.ResourceWithImportState = &repositoryCollaboratorResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryCollaboratorResource{}
+)
+
+// collaboratorPermissions are the access levels a collaborator can be granted.
+var collaboratorPermissions = []string{
+	string(gitea.AccessModeRead),
+	string(gitea.AccessModeWrite),
+	string(gitea.AccessModeAdmin),
+}
+
+func NewRepositoryCollaboratorResource() resource.Resource {
+	return &repositoryCollaboratorResource{}
+}
+
+type repositoryCollaboratorResource struct {
+	client       *gitea.Client
+	providerData *giteaProviderData
+}
+
+type repositoryCollaboratorResourceModel struct {
+	// Required
+	Repository types.String `tfsdk:"repository"`
+	Username   types.String `tfsdk:"username"`
+
+	// Optional
+	Owner      types.String `tfsdk:"owner"`
+	Permission types.String `tfsdk:"permission"`
+
+	// Computed
+	Id types.String `tfsdk:"id"`
+}
+
+func (r *repositoryCollaboratorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_collaborator"
+}
+
+func (r *repositoryCollaboratorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a collaborator of a Gitea repository.",
+		MarkdownDescription: "Manages a collaborator of a Gitea repository. This resource grants an individual user read, write or admin access to a personal or organization repository. The permission is read back as the user's effective access, so a team membership granting more access than the collaboration shows up as drift.",
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the repository.",
+				MarkdownDescription: "Name of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"username": schema.StringAttribute{
+				Required:            true,
+				Description:         "Username of the collaborator.",
+				MarkdownDescription: "Username of the collaborator.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Optional
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Owner of the repository. Defaults to the provider's default_owner.",
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"permission": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(gitea.AccessModeWrite)),
+				Description:         "Access granted to the collaborator: read, write or admin. Defaults to write.",
+				MarkdownDescription: "Access granted to the collaborator: `read`, `write` or `admin`. Defaults to `write`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(collaboratorPermissions...),
+				},
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of this resource, in the format owner/repository/username.",
+				MarkdownDescription: "The ID of this resource, in the format `owner/repository/username`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *repositoryCollaboratorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*giteaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner.
+func (r *repositoryCollaboratorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+}
+
+func (r *repositoryCollaboratorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryCollaboratorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+	username := plan.Username.ValueString()
+
+	permission := gitea.AccessMode(plan.Permission.ValueString())
+	_, err := client.AddCollaborator(owner, repo, username, gitea.AddCollaboratorOption{
+		Permission: &permission,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Adding Collaborator",
+			fmt.Sprintf("Could not add user '%s' as a collaborator of repository '%s/%s': %s", username, owner, repo, err.Error()),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", owner, repo, username))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryCollaboratorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryCollaboratorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+	username := state.Username.ValueString()
+
+	permission, found, err := readCollaboratorPermission(client, owner, repo, username)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Collaborator",
+			fmt.Sprintf("Could not read collaborator '%s' of repository '%s/%s': %s", username, owner, repo, err.Error()),
+		)
+		return
+	}
+
+	// The collaborator or the repository was removed outside of Terraform
+	if !found {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.Permission = types.StringValue(permission)
+	state.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", owner, repo, username))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *repositoryCollaboratorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryCollaboratorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+	username := plan.Username.ValueString()
+
+	// Adding an existing collaborator again changes its permission
+	permission := gitea.AccessMode(plan.Permission.ValueString())
+	_, err := client.AddCollaborator(owner, repo, username, gitea.AddCollaboratorOption{
+		Permission: &permission,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Collaborator",
+			fmt.Sprintf("Could not update the permission of collaborator '%s' of repository '%s/%s': %s", username, owner, repo, err.Error()),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", owner, repo, username))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryCollaboratorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryCollaboratorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+	username := state.Username.ValueString()
+
+	httpResp, err := client.DeleteCollaborator(owner, repo, username)
+	if err != nil {
+		// If already removed (404), consider it a success
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Removing Collaborator",
+			fmt.Sprintf("Could not remove collaborator '%s' from repository '%s/%s': %s", username, owner, repo, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *repositoryCollaboratorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
+	// Import format: "owner/repository/username"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in format 'owner/repository/username', got: %s", req.ID),
+		)
+		return
+	}
+
+	owner, repo, username := parts[0], parts[1], parts[2]
+
+	permission, found, err := readCollaboratorPermission(client, owner, repo, username)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Collaborator",
+			fmt.Sprintf("Could not read collaborator '%s' of repository '%s/%s': %s", username, owner, repo, err.Error()),
+		)
+		return
+	}
+	if !found {
+		resp.Diagnostics.AddError(
+			"Collaborator Not Found",
+			fmt.Sprintf("User '%s' is not a collaborator of repository '%s/%s'.", username, owner, repo),
+		)
+		return
+	}
+
+	state := repositoryCollaboratorResourceModel{
+		Id:         types.StringValue(req.ID),
+		Owner:      types.StringValue(owner),
+		Repository: types.StringValue(repo),
+		Username:   types.StringValue(username),
+		Permission: types.StringValue(permission),
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// readCollaboratorPermission returns the permission of username on the
+// repository, and whether the user is a collaborator at all. A missing
+// repository is reported as not found.
+func readCollaboratorPermission(client *gitea.Client, owner, repo, username string) (string, bool, error) {
+	isCollaborator, httpResp, err := client.IsCollaborator(owner, repo, username)
+	if err != nil {
+		return "", false, err
+	}
+	if !isCollaborator {
+		// Anything but 404 is a server error rather than a missing collaborator
+		if httpResp != nil && httpResp.StatusCode != 404 {
+			return "", false, fmt.Errorf("unexpected status code %d", httpResp.StatusCode)
+		}
+		return "", false, nil
+	}
+
+	result, _, err := client.CollaboratorPermission(owner, repo, username)
+	if err != nil {
+		return "", false, err
+	}
+	if result == nil {
+		return "", false, nil
+	}
+
+	return normalizeCollaboratorPermission(result.Permission), true, nil
+}
+
+// normalizeCollaboratorPermission maps the access mode reported by Gitea to a
+// permission that can be granted. Site administrators are reported as owners,
+// which is granted as admin.
+func normalizeCollaboratorPermission(mode gitea.AccessMode) string {
+	if mode == gitea.AccessModeOwner {
+		return string(gitea.AccessModeAdmin)
+	}
+	return string(mode)
+}
@@ -0,0 +1,64 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRepositoryCollaboratorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRepositoryCollaboratorResourceConfig("read"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_collaborator.test", "owner", "root"),
+					resource.TestCheckResourceAttr("gitea_repository_collaborator.test", "username", "collabuser"),
+					resource.TestCheckResourceAttr("gitea_repository_collaborator.test", "permission", "read"),
+					resource.TestCheckResourceAttr("gitea_repository_collaborator.test", "id", "root/test-repo-collaborator/collabuser"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "gitea_repository_collaborator.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRepositoryCollaboratorResourceConfig("admin"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_collaborator.test", "permission", "admin"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryCollaboratorResourceConfig(permission string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "gitea_repository" "test" {
+  username = "root"
+  name     = "test-repo-collaborator"
+  private  = true
+}
+
+resource "gitea_user" "test" {
+  username   = "collabuser"
+  login_name = "collabuser"
+  email      = "collabuser@example.com"
+  password   = "testpass123"
+}
+
+resource "gitea_repository_collaborator" "test" {
+  owner      = gitea_repository.test.username
+  repository = gitea_repository.test.name
+  username   = gitea_user.test.username
+  permission = %[1]q
+}
+`, permission)
+}
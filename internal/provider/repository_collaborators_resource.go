@@ -0,0 +1,440 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &repositoryCollaboratorsResource{}
+	_ resource.ResourceWithConfigure   = &repositoryCollaboratorsResource{}
+	_ resource.ResourceWithImportState = &repositoryCollaboratorsResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryCollaboratorsResource{}
+)
+
+func NewRepositoryCollaboratorsResource() resource.Resource {
+	return &repositoryCollaboratorsResource{}
+}
+
+type repositoryCollaboratorsResource struct {
+	client       *gitea.Client
+	providerData *giteaProviderData
+}
+
+type repositoryCollaboratorsResourceModel struct {
+	// Required
+	Repository    types.String `tfsdk:"repository"`
+	Collaborators types.Map    `tfsdk:"collaborators"`
+
+	// Optional
+	Owner types.String `tfsdk:"owner"`
+
+	// Computed
+	Id types.String `tfsdk:"id"`
+}
+
+func (r *repositoryCollaboratorsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_collaborators"
+}
+
+func (r *repositoryCollaboratorsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages the complete list of collaborators of a Gitea repository.",
+		MarkdownDescription: "Manages the complete list of collaborators of a Gitea repository. This resource is authoritative: collaborators added outside of Terraform, for example through the web UI, are reported as drift and removed on the next apply. Do not combine it with `gitea_repository_collaborator` for the same repository.\n\nGitea reports the highest permission a collaborator has on the repository, including permission granted through teams of the owning organization. Configure that permission for collaborators who are also team members, otherwise applying fails with an unexpected permission.",
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the repository.",
+				MarkdownDescription: "Name of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"collaborators": schema.MapAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				Description:         "Map of username to the permission granted to that collaborator: read, write or admin. An empty map removes all collaborators.",
+				MarkdownDescription: "Map of username to the permission granted to that collaborator: `read`, `write` or `admin`. An empty map removes all collaborators.",
+				Validators: []validator.Map{
+					mapvalidator.ValueStringsAre(stringvalidator.OneOf(collaboratorPermissions...)),
+				},
+			},
+
+			// Optional
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Owner of the repository. Defaults to the provider's default_owner.",
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of this resource, in the format owner/repository.",
+				MarkdownDescription: "The ID of this resource, in the format `owner/repository`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *repositoryCollaboratorsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*giteaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner.
+func (r *repositoryCollaboratorsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+}
+
+func (r *repositoryCollaboratorsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryCollaboratorsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(readAppliedCollaborators(ctx, client, &plan)...)
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", plan.Owner.ValueString(), plan.Repository.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryCollaboratorsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryCollaboratorsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+
+	// Handle 404 - the repository was deleted outside of Terraform
+	if _, httpResp, err := client.GetRepo(owner, repo); err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Repository",
+			fmt.Sprintf("Could not read repository '%s/%s': %s", owner, repo, err.Error()),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(readCollaborators(ctx, client, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Id = types.StringValue(fmt.Sprintf("%s/%s", owner, repo))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *repositoryCollaboratorsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryCollaboratorsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.reconcile(ctx, client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(readAppliedCollaborators(ctx, client, &plan)...)
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", plan.Owner.ValueString(), plan.Repository.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryCollaboratorsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryCollaboratorsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var collaborators map[string]string
+	resp.Diagnostics.Append(state.Collaborators.ElementsAs(ctx, &collaborators, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+
+	for username := range collaborators {
+		httpResp, err := client.DeleteCollaborator(owner, repo, username)
+		if err != nil {
+			// If already removed (404), consider it a success
+			if httpResp != nil && httpResp.StatusCode == 404 {
+				continue
+			}
+			resp.Diagnostics.AddError(
+				"Error Removing Collaborator",
+				fmt.Sprintf("Could not remove collaborator '%s' from repository '%s/%s': %s", username, owner, repo, err.Error()),
+			)
+		}
+	}
+}
+
+func (r *repositoryCollaboratorsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: "owner/repository"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in format 'owner/repository', got: %s", req.ID),
+		)
+		return
+	}
+
+	// Read fills in the collaborators
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("collaborators"), map[string]string{})...)
+}
+
+// reconcile makes the repository's collaborators match the plan: missing
+// collaborators are added, permissions are corrected and any collaborator not
+// in the plan is removed.
+func (r *repositoryCollaboratorsResource) reconcile(ctx context.Context, client *gitea.Client, plan *repositoryCollaboratorsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+
+	var desired map[string]string
+	diags.Append(plan.Collaborators.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	current, err := listCollaboratorPermissions(client, owner, repo)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Collaborators",
+			fmt.Sprintf("Could not list collaborators of repository '%s/%s': %s", owner, repo, err.Error()),
+		)
+		return diags
+	}
+
+	for username := range current {
+		if _, ok := desired[matchUsername(desired, username)]; ok {
+			continue
+		}
+		if _, err := client.DeleteCollaborator(owner, repo, username); err != nil {
+			diags.AddError(
+				"Error Removing Collaborator",
+				fmt.Sprintf("Could not remove collaborator '%s' from repository '%s/%s': %s", username, owner, repo, err.Error()),
+			)
+		}
+	}
+
+	for username, permission := range desired {
+		if current[matchUsername(current, username)] == permission {
+			continue
+		}
+		mode := gitea.AccessMode(permission)
+		if _, err := client.AddCollaborator(owner, repo, username, gitea.AddCollaboratorOption{Permission: &mode}); err != nil {
+			diags.AddError(
+				"Error Adding Collaborator",
+				fmt.Sprintf("Could not add user '%s' as a collaborator of repository '%s/%s': %s", username, owner, repo, err.Error()),
+			)
+		}
+	}
+
+	return diags
+}
+
+// readCollaborators replaces the collaborators of model with the permissions
+// currently granted on the repository, keeping the spelling of usernames
+// already in model as Gitea ignores case.
+func readCollaborators(ctx context.Context, client *gitea.Client, model *repositoryCollaboratorsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	owner := model.Owner.ValueString()
+	repo := model.Repository.ValueString()
+
+	current, err := listCollaboratorPermissions(client, owner, repo)
+	if err != nil {
+		diags.AddError(
+			"Error Reading Collaborators",
+			fmt.Sprintf("Could not list collaborators of repository '%s/%s': %s", owner, repo, err.Error()),
+		)
+		return diags
+	}
+
+	var known map[string]string
+	diags.Append(model.Collaborators.ElementsAs(ctx, &known, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	collaborators := make(map[string]string, len(current))
+	for username, permission := range current {
+		collaborators[matchUsername(known, username)] = permission
+	}
+
+	var d diag.Diagnostics
+	model.Collaborators, d = types.MapValueFrom(ctx, types.StringType, collaborators)
+	diags.Append(d...)
+	return diags
+}
+
+// readAppliedCollaborators re-reads the collaborators into plan after
+// reconcile. Gitea only reports the effective permission, so a team of the
+// owning organization granting more than the planned permission is reported
+// as an error instead of surfacing as drift on every plan.
+func readAppliedCollaborators(ctx context.Context, client *gitea.Client, plan *repositoryCollaboratorsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	var desired map[string]string
+	diags.Append(plan.Collaborators.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	diags.Append(readCollaborators(ctx, client, plan)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	var applied map[string]string
+	diags.Append(plan.Collaborators.ElementsAs(ctx, &applied, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, username := range slices.Sorted(maps.Keys(desired)) {
+		if applied[username] == desired[username] {
+			continue
+		}
+		diags.AddAttributeError(
+			path.Root("collaborators").AtMapKey(username),
+			"Unexpected Collaborator Permission",
+			fmt.Sprintf("Collaborator '%s' of repository '%s/%s' has '%s' permission instead of '%s'. "+
+				"Gitea reports the highest permission granted to the user, including through teams of the owning organization; "+
+				"configure that permission or remove the user from the team.",
+				username, plan.Owner.ValueString(), plan.Repository.ValueString(), applied[username], desired[username]),
+		)
+	}
+
+	return diags
+}
+
+// listCollaboratorPermissions returns every collaborator of the repository
+// mapped to its permission.
+func listCollaboratorPermissions(client *gitea.Client, owner, repo string) (map[string]string, error) {
+	collaborators := make(map[string]string)
+
+	page := 1
+	for {
+		users, _, err := client.ListCollaborators(owner, repo, gitea.ListCollaboratorsOptions{
+			ListOptions: gitea.ListOptions{
+				Page:     page,
+				PageSize: 50, // Use default max page size
+			},
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		for _, user := range users {
+			result, _, err := client.CollaboratorPermission(owner, repo, user.UserName)
+			if err != nil {
+				return nil, err
+			}
+			if result != nil {
+				collaborators[user.UserName] = normalizeCollaboratorPermission(result.Permission)
+			}
+		}
+
+		// No more results if we got fewer than page size
+		if len(users) < 50 {
+			break
+		}
+
+		page++
+	}
+
+	return collaborators, nil
+}
+
+// matchUsername returns the key of usernames that equals username ignoring
+// case, or username itself when there is none.
+func matchUsername(usernames map[string]string, username string) string {
+	if _, ok := usernames[username]; ok {
+		return username
+	}
+	for key := range usernames {
+		if strings.EqualFold(key, username) {
+			return key
+		}
+	}
+	return username
+}
@@ -0,0 +1,91 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestMatchUsername(t *testing.T) {
+	usernames := map[string]string{"Alice": "read", "bob": "write"}
+
+	for username, want := range map[string]string{
+		"Alice": "Alice",
+		"alice": "Alice",
+		"BOB":   "bob",
+		"carol": "carol",
+	} {
+		if got := matchUsername(usernames, username); got != want {
+			t.Errorf("matchUsername(%q) = %q, want %q", username, got, want)
+		}
+	}
+}
+
+func TestAccRepositoryCollaboratorsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRepositoryCollaboratorsResourceConfig(`{
+    (gitea_user.first.username)  = "read"
+    (gitea_user.second.username) = "write"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_collaborators.test", "id", "root/test-repo-collaborators"),
+					resource.TestCheckResourceAttr("gitea_repository_collaborators.test", "collaborators.%", "2"),
+					resource.TestCheckResourceAttr("gitea_repository_collaborators.test", "collaborators.collabfirst", "read"),
+					resource.TestCheckResourceAttr("gitea_repository_collaborators.test", "collaborators.collabsecond", "write"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "gitea_repository_collaborators.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing, the removed collaborator loses access
+			{
+				Config: testAccRepositoryCollaboratorsResourceConfig(`{
+    (gitea_user.first.username) = "admin"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_collaborators.test", "collaborators.%", "1"),
+					resource.TestCheckResourceAttr("gitea_repository_collaborators.test", "collaborators.collabfirst", "admin"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryCollaboratorsResourceConfig(collaborators string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "gitea_repository" "test" {
+  username = "root"
+  name     = "test-repo-collaborators"
+  private  = true
+}
+
+resource "gitea_user" "first" {
+  username   = "collabfirst"
+  login_name = "collabfirst"
+  email      = "collabfirst@example.com"
+  password   = "testpass123"
+}
+
+resource "gitea_user" "second" {
+  username   = "collabsecond"
+  login_name = "collabsecond"
+  email      = "collabsecond@example.com"
+  password   = "testpass123"
+}
+
+resource "gitea_repository_collaborators" "test" {
+  owner         = gitea_repository.test.username
+  repository    = gitea_repository.test.name
+  collaborators = %[1]s
+}
+`, collaborators)
+}
@@ -0,0 +1,517 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &repositoryFileResource{}
+	_ resource.ResourceWithConfigure   = &repositoryFileResource{}
+	_ resource.ResourceWithImportState = &repositoryFileResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryFileResource{}
+)
+
+func NewRepositoryFileResource() resource.Resource {
+	return &repositoryFileResource{}
+}
+
+type repositoryFileResource struct {
+	client       *gitea.Client
+	providerData *giteaProviderData
+}
+
+type repositoryFileResourceModel struct {
+	// Required
+	Repository types.String `tfsdk:"repository"`
+	File       types.String `tfsdk:"file"`
+	Content    types.String `tfsdk:"content"`
+
+	// Optional
+	Owner             types.String `tfsdk:"owner"`
+	Branch            types.String `tfsdk:"branch"`
+	CommitMessage     types.String `tfsdk:"commit_message"`
+	AuthorName        types.String `tfsdk:"author_name"`
+	AuthorEmail       types.String `tfsdk:"author_email"`
+	CommitterName     types.String `tfsdk:"committer_name"`
+	CommitterEmail    types.String `tfsdk:"committer_email"`
+	OverwriteOnCreate types.Bool   `tfsdk:"overwrite_on_create"`
+
+	// Computed
+	Id        types.String `tfsdk:"id"`
+	Sha       types.String `tfsdk:"sha"`
+	CommitSha types.String `tfsdk:"commit_sha"`
+}
+
+func (r *repositoryFileResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_file"
+}
+
+func (r *repositoryFileResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a file in a Gitea repository.",
+		MarkdownDescription: "Manages a file in a Gitea repository. Every change of the content is committed to the branch, and changes made to the file outside of Terraform are reported as drift. Destroying the resource commits the deletion of the file.",
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the repository.",
+				MarkdownDescription: "Name of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"file": schema.StringAttribute{
+				Required:            true,
+				Description:         "Path of the file in the repository, such as .gitea/CODEOWNERS.",
+				MarkdownDescription: "Path of the file in the repository, such as `.gitea/CODEOWNERS`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"content": schema.StringAttribute{
+				Required:            true,
+				Description:         "Content of the file.",
+				MarkdownDescription: "Content of the file.",
+			},
+
+			// Optional
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Owner of the repository. Defaults to the provider's default_owner.",
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Branch to commit to. Defaults to the default branch of the repository.",
+				MarkdownDescription: "Branch to commit to. Defaults to the default branch of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"commit_message": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Message of the commits made by this resource. Gitea generates a message when it is not set.",
+				MarkdownDescription: "Message of the commits made by this resource. Gitea generates a message when it is not set.",
+			},
+			"author_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Name of the commit author. Defaults to the committer, or to the authenticated user.",
+				MarkdownDescription: "Name of the commit author. Defaults to the committer, or to the authenticated user.",
+			},
+			"author_email": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Email address of the commit author.",
+				MarkdownDescription: "Email address of the commit author.",
+			},
+			"committer_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Name of the committer. Defaults to the author, or to the authenticated user.",
+				MarkdownDescription: "Name of the committer. Defaults to the author, or to the authenticated user.",
+			},
+			"committer_email": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Email address of the committer.",
+				MarkdownDescription: "Email address of the committer.",
+			},
+			"overwrite_on_create": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether to take over and overwrite a file that already exists when the resource is created. Otherwise creating the resource fails for an existing file. Defaults to false.",
+				MarkdownDescription: "Whether to take over and overwrite a file that already exists when the resource is created. Otherwise creating the resource fails for an existing file. Defaults to `false`.",
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the file in the format owner/repository/file:branch.",
+				MarkdownDescription: "The ID of the file in the format `owner/repository/file:branch`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"sha": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Git blob SHA of the file.",
+				MarkdownDescription: "Git blob SHA of the file.",
+			},
+			"commit_sha": schema.StringAttribute{
+				Computed:            true,
+				Description:         "SHA of the last commit that changed the file.",
+				MarkdownDescription: "SHA of the last commit that changed the file.",
+			},
+		},
+	}
+}
+
+func (r *repositoryFileResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*giteaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner.
+func (r *repositoryFileResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+}
+
+func (r *repositoryFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryFileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+	file := plan.File.ValueString()
+
+	branch, err := resolveBranch(client, owner, repo, plan.Branch)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Repository",
+			fmt.Sprintf("Could not read the default branch of repository '%s/%s': %s", owner, repo, err.Error()),
+		)
+		return
+	}
+	plan.Branch = types.StringValue(branch)
+
+	fileOptions := fileCommitOptions(branch, plan.CommitMessage, plan.AuthorName, plan.AuthorEmail, plan.CommitterName, plan.CommitterEmail)
+	content := base64.StdEncoding.EncodeToString([]byte(plan.Content.ValueString()))
+
+	// An existing file is only taken over when asked to
+	var existing *gitea.ContentsResponse
+	if plan.OverwriteOnCreate.ValueBool() {
+		contents, httpResp, err := client.GetContents(owner, repo, branch, file)
+		if err != nil && (httpResp == nil || httpResp.StatusCode != 404) {
+			resp.Diagnostics.AddError(
+				"Error Reading File",
+				fmt.Sprintf("Could not read file '%s' on branch '%s' of repository '%s/%s': %s", file, branch, owner, repo, err.Error()),
+			)
+			return
+		}
+		if err == nil {
+			existing = contents
+		}
+	}
+
+	var fileResp *gitea.FileResponse
+	var httpResp *gitea.Response
+	if existing != nil {
+		fileResp, httpResp, err = client.UpdateFile(owner, repo, file, gitea.UpdateFileOptions{
+			FileOptions: fileOptions,
+			SHA:         existing.SHA,
+			Content:     content,
+		})
+	} else {
+		fileResp, httpResp, err = client.CreateFile(owner, repo, file, gitea.CreateFileOptions{
+			FileOptions: fileOptions,
+			Content:     content,
+		})
+	}
+	if err != nil {
+		detail := fmt.Sprintf("Could not create file '%s' on branch '%s' of repository '%s/%s': %s", file, branch, owner, repo, err.Error())
+		if httpResp != nil && httpResp.StatusCode == 422 && !plan.OverwriteOnCreate.ValueBool() {
+			detail += "\n\nSet overwrite_on_create = true to take over a file that already exists."
+		}
+		resp.Diagnostics.AddError("Error Creating File", detail)
+		return
+	}
+
+	plan.Id = types.StringValue(repositoryFileID(owner, repo, file, branch))
+	setRepositoryFileComputed(&plan, fileResp)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryFileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+	file := state.File.ValueString()
+	branch := state.Branch.ValueString()
+
+	contents, httpResp, err := client.GetContents(owner, repo, branch, file)
+	if err != nil {
+		// Handle 404 - file, branch or repository was deleted outside of Terraform
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading File",
+			fmt.Sprintf("Could not read file '%s' on branch '%s' of repository '%s/%s': %s", file, branch, owner, repo, err.Error()),
+		)
+		return
+	}
+
+	// The content is only decoded when the blob changed, so an unchanged file
+	// keeps the content exactly as configured
+	if contents.SHA != state.Sha.ValueString() {
+		content, err := decodeFileContent(contents)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading File",
+				fmt.Sprintf("Could not decode file '%s' on branch '%s' of repository '%s/%s': %s", file, branch, owner, repo, err.Error()),
+			)
+			return
+		}
+		state.Content = types.StringValue(content)
+		state.Sha = types.StringValue(contents.SHA)
+	}
+	if contents.LastCommitSha != "" {
+		state.CommitSha = types.StringValue(contents.LastCommitSha)
+	}
+	state.Id = types.StringValue(repositoryFileID(owner, repo, file, branch))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *repositoryFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan, state repositoryFileResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The commit attributes only apply to future commits
+	if plan.Content.Equal(state.Content) {
+		plan.Sha = state.Sha
+		plan.CommitSha = state.CommitSha
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+		return
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+	file := plan.File.ValueString()
+	branch := plan.Branch.ValueString()
+
+	fileResp, _, err := client.UpdateFile(owner, repo, file, gitea.UpdateFileOptions{
+		FileOptions: fileCommitOptions(branch, plan.CommitMessage, plan.AuthorName, plan.AuthorEmail, plan.CommitterName, plan.CommitterEmail),
+		SHA:         state.Sha.ValueString(),
+		Content:     base64.StdEncoding.EncodeToString([]byte(plan.Content.ValueString())),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating File",
+			fmt.Sprintf("Could not update file '%s' on branch '%s' of repository '%s/%s': %s", file, branch, owner, repo, err.Error()),
+		)
+		return
+	}
+
+	setRepositoryFileComputed(&plan, fileResp)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryFileResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+	file := state.File.ValueString()
+	branch := state.Branch.ValueString()
+
+	httpResp, err := client.DeleteFile(owner, repo, file, gitea.DeleteFileOptions{
+		FileOptions: fileCommitOptions(branch, state.CommitMessage, state.AuthorName, state.AuthorEmail, state.CommitterName, state.CommitterEmail),
+		SHA:         state.Sha.ValueString(),
+	})
+	if err != nil {
+		// If already deleted (404), consider it a success
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting File",
+			fmt.Sprintf("Could not delete file '%s' on branch '%s' of repository '%s/%s': %s", file, branch, owner, repo, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *repositoryFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
+	// Import format: "owner/repository/file" or "owner/repository/file:branch"
+	owner, repo, file, branch, ok := parseRepositoryFileID(req.ID)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in format 'owner/repository/file' or 'owner/repository/file:branch', got: %s", req.ID),
+		)
+		return
+	}
+
+	if branch == "" {
+		var err error
+		branch, err = resolveBranch(client, owner, repo, types.StringNull())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing File",
+				fmt.Sprintf("Could not read the default branch of repository '%s/%s': %s", owner, repo, err.Error()),
+			)
+			return
+		}
+	}
+
+	// Read fills in the content and the computed attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), repositoryFileID(owner, repo, file, branch))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), owner)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repo)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file"), file)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch"), branch)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("overwrite_on_create"), false)...)
+}
+
+// resolveBranch returns the configured branch, or the default branch of the
+// repository when none is configured.
+func resolveBranch(client *gitea.Client, owner, repo string, branch types.String) (string, error) {
+	if !branch.IsNull() && !branch.IsUnknown() && branch.ValueString() != "" {
+		return branch.ValueString(), nil
+	}
+
+	repository, _, err := client.GetRepo(owner, repo)
+	if err != nil {
+		return "", err
+	}
+	return repository.DefaultBranch, nil
+}
+
+// fileCommitOptions builds the commit options shared by the file APIs. Empty
+// identities are left to Gitea, which falls back to the authenticated user.
+func fileCommitOptions(branch string, message, authorName, authorEmail, committerName, committerEmail types.String) gitea.FileOptions {
+	return gitea.FileOptions{
+		Message:    message.ValueString(),
+		BranchName: branch,
+		Author: gitea.Identity{
+			Name:  authorName.ValueString(),
+			Email: authorEmail.ValueString(),
+		},
+		Committer: gitea.Identity{
+			Name:  committerName.ValueString(),
+			Email: committerEmail.ValueString(),
+		},
+	}
+}
+
+// decodeFileContent returns the content of a file as reported by the contents
+// API, which encodes it in base64.
+func decodeFileContent(contents *gitea.ContentsResponse) (string, error) {
+	if contents.Type != "file" {
+		return "", fmt.Errorf("'%s' is a %s, not a file", contents.Path, contents.Type)
+	}
+	if contents.Content == nil {
+		return "", nil
+	}
+	if contents.Encoding != nil && *contents.Encoding != "base64" {
+		return "", fmt.Errorf("unsupported encoding '%s'", *contents.Encoding)
+	}
+
+	content, err := base64.StdEncoding.DecodeString(*contents.Content)
+	if err != nil {
+		return "", err
+	}
+	return string(content), nil
+}
+
+// repositoryFileID returns the ID of a file resource.
+func repositoryFileID(owner, repo, file, branch string) string {
+	return fmt.Sprintf("%s/%s/%s:%s", owner, repo, file, branch)
+}
+
+// parseRepositoryFileID splits an ID in the format "owner/repository/file",
+// optionally followed by ":branch". The branch is empty when it is omitted.
+func parseRepositoryFileID(id string) (owner, repo, file, branch string, ok bool) {
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" {
+		return "", "", "", "", false
+	}
+
+	file = parts[2]
+	if i := strings.LastIndex(file, ":"); i >= 0 {
+		file, branch = file[:i], file[i+1:]
+		if branch == "" {
+			return "", "", "", "", false
+		}
+	}
+	if file == "" {
+		return "", "", "", "", false
+	}
+
+	return parts[0], parts[1], file, branch, true
+}
+
+// setRepositoryFileComputed copies the attributes Gitea computes from the
+// response to a file change.
+func setRepositoryFileComputed(model *repositoryFileResourceModel, fileResp *gitea.FileResponse) {
+	model.Sha = types.StringValue("")
+	if fileResp.Content != nil {
+		model.Sha = types.StringValue(fileResp.Content.SHA)
+	}
+	model.CommitSha = types.StringValue("")
+	if fileResp.Commit != nil {
+		model.CommitSha = types.StringValue(fileResp.Commit.SHA)
+	}
+}
@@ -0,0 +1,89 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestParseRepositoryFileID(t *testing.T) {
+	tests := []struct {
+		id                        string
+		owner, repo, file, branch string
+		ok                        bool
+	}{
+		{"org/repo/CODEOWNERS", "org", "repo", "CODEOWNERS", "", true},
+		{"org/repo/.gitea/workflows/ci.yml", "org", "repo", ".gitea/workflows/ci.yml", "", true},
+		{"org/repo/.gitea/workflows/ci.yml:release/v1", "org", "repo", ".gitea/workflows/ci.yml", "release/v1", true},
+		{"org/repo", "", "", "", "", false},
+		{"org//CODEOWNERS", "", "", "", "", false},
+		{"org/repo/", "", "", "", "", false},
+		{"org/repo/CODEOWNERS:", "", "", "", "", false},
+		{"org/repo/:main", "", "", "", "", false},
+	}
+
+	for _, tt := range tests {
+		owner, repo, file, branch, ok := parseRepositoryFileID(tt.id)
+		if ok != tt.ok || owner != tt.owner || repo != tt.repo || file != tt.file || branch != tt.branch {
+			t.Errorf("parseRepositoryFileID(%q) = %q, %q, %q, %q, %v; want %q, %q, %q, %q, %v",
+				tt.id, owner, repo, file, branch, ok, tt.owner, tt.repo, tt.file, tt.branch, tt.ok)
+		}
+	}
+}
+
+func TestAccRepositoryFileResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRepositoryFileResourceConfig("* @root\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_file.test", "id", "root/test-repo-file/.gitea/CODEOWNERS:main"),
+					resource.TestCheckResourceAttr("gitea_repository_file.test", "branch", "main"),
+					resource.TestCheckResourceAttr("gitea_repository_file.test", "content", "* @root\n"),
+					resource.TestCheckResourceAttrSet("gitea_repository_file.test", "sha"),
+					resource.TestCheckResourceAttrSet("gitea_repository_file.test", "commit_sha"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "gitea_repository_file.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_message", "author_name", "author_email"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccRepositoryFileResourceConfig("* @root\ndocs/ @admin\n"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_file.test", "content", "* @root\ndocs/ @admin\n"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryFileResourceConfig(content string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "gitea_repository" "test" {
+  username       = "root"
+  name           = "test-repo-file"
+  private        = false
+  auto_init      = true
+  default_branch = "main"
+}
+
+resource "gitea_repository_file" "test" {
+  owner          = gitea_repository.test.username
+  repository     = gitea_repository.test.name
+  file           = ".gitea/CODEOWNERS"
+  content        = %q
+  commit_message = "Manage CODEOWNERS"
+  author_name    = "Terraform"
+  author_email   = "terraform@example.com"
+}
+`, content)
+}
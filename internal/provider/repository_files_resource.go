@@ -0,0 +1,533 @@
+package provider
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"maps"
+	"slices"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &repositoryFilesResource{}
+	_ resource.ResourceWithConfigure   = &repositoryFilesResource{}
+	_ resource.ResourceWithImportState = &repositoryFilesResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryFilesResource{}
+)
+
+func NewRepositoryFilesResource() resource.Resource {
+	return &repositoryFilesResource{}
+}
+
+type repositoryFilesResource struct {
+	client       *gitea.Client
+	providerData *giteaProviderData
+}
+
+type repositoryFilesResourceModel struct {
+	// Required
+	Repository types.String `tfsdk:"repository"`
+	Files      types.Map    `tfsdk:"files"`
+
+	// Optional
+	Owner             types.String `tfsdk:"owner"`
+	Branch            types.String `tfsdk:"branch"`
+	CommitMessage     types.String `tfsdk:"commit_message"`
+	AuthorName        types.String `tfsdk:"author_name"`
+	AuthorEmail       types.String `tfsdk:"author_email"`
+	CommitterName     types.String `tfsdk:"committer_name"`
+	CommitterEmail    types.String `tfsdk:"committer_email"`
+	OverwriteOnCreate types.Bool   `tfsdk:"overwrite_on_create"`
+
+	// Computed
+	Id        types.String `tfsdk:"id"`
+	FileShas  types.Map    `tfsdk:"file_shas"`
+	CommitSha types.String `tfsdk:"commit_sha"`
+}
+
+func (r *repositoryFilesResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_files"
+}
+
+func (r *repositoryFilesResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a set of files in a Gitea repository, committing all changes of an apply in a single commit.",
+		MarkdownDescription: "Manages a set of files in a Gitea repository, committing all changes of an apply in a single commit. Changes made to a file outside of Terraform are reported as drift for that file. Files removed from the configuration are deleted in the same commit, and destroying the resource deletes all of them. Requires Gitea 1.25.0 or later.",
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the repository.",
+				MarkdownDescription: "Name of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"files": schema.MapAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				Description:         "Map of file path to the content of that file, such as .gitea/CODEOWNERS.",
+				MarkdownDescription: "Map of file path to the content of that file, such as `.gitea/CODEOWNERS`.",
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+					mapvalidator.KeysAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+
+			// Optional
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Owner of the repository. Defaults to the provider's default_owner.",
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"branch": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Branch to commit to. Defaults to the default branch of the repository.",
+				MarkdownDescription: "Branch to commit to. Defaults to the default branch of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"commit_message": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Message of the commits made by this resource. Gitea generates a message when it is not set.",
+				MarkdownDescription: "Message of the commits made by this resource. Gitea generates a message when it is not set.",
+			},
+			"author_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Name of the commit author. Defaults to the committer, or to the authenticated user.",
+				MarkdownDescription: "Name of the commit author. Defaults to the committer, or to the authenticated user.",
+			},
+			"author_email": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Email address of the commit author.",
+				MarkdownDescription: "Email address of the commit author.",
+			},
+			"committer_name": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Name of the committer. Defaults to the author, or to the authenticated user.",
+				MarkdownDescription: "Name of the committer. Defaults to the author, or to the authenticated user.",
+			},
+			"committer_email": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Email address of the committer.",
+				MarkdownDescription: "Email address of the committer.",
+			},
+			"overwrite_on_create": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether to take over and overwrite files that already exist when they are added to the resource. Otherwise the commit fails for an existing file. Defaults to false.",
+				MarkdownDescription: "Whether to take over and overwrite files that already exist when they are added to the resource. Otherwise the commit fails for an existing file. Defaults to `false`.",
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of this resource, in the format owner/repository:branch.",
+				MarkdownDescription: "The ID of this resource, in the format `owner/repository:branch`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"file_shas": schema.MapAttribute{
+				Computed:            true,
+				ElementType:         types.StringType,
+				Description:         "Map of file path to the Git blob SHA of that file.",
+				MarkdownDescription: "Map of file path to the Git blob SHA of that file.",
+			},
+			"commit_sha": schema.StringAttribute{
+				Computed:            true,
+				Description:         "SHA of the last commit made by this resource.",
+				MarkdownDescription: "SHA of the last commit made by this resource.",
+			},
+		},
+	}
+}
+
+func (r *repositoryFilesResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*giteaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner and
+// fails planning early on servers that predate the change-files endpoint.
+func (r *repositoryFilesResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+
+	resp.Diagnostics.Append(r.providerData.checkServerVersion("The gitea_repository_files resource", "1.25.0")...)
+}
+
+func (r *repositoryFilesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryFilesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+
+	branch, err := resolveBranch(client, owner, repo, plan.Branch)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading Repository",
+			fmt.Sprintf("Could not read the default branch of repository '%s/%s': %s", owner, repo, err.Error()),
+		)
+		return
+	}
+	plan.Branch = types.StringValue(branch)
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s:%s", owner, repo, branch))
+
+	resp.Diagnostics.Append(r.commit(ctx, client, &plan, map[string]string{}, map[string]string{}, "")...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryFilesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryFilesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+	branch := state.Branch.ValueString()
+
+	var files, shas map[string]string
+	resp.Diagnostics.Append(state.Files.ElementsAs(ctx, &files, false)...)
+	resp.Diagnostics.Append(filesShasOf(ctx, state.FileShas, &shas)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Each file is checked on its own, so drift is reported per file
+	for file := range files {
+		contents, httpResp, err := client.GetContents(owner, repo, branch, file)
+		if err != nil {
+			// Handle 404 - the file was deleted outside of Terraform
+			if httpResp != nil && httpResp.StatusCode == 404 {
+				delete(files, file)
+				delete(shas, file)
+				continue
+			}
+			resp.Diagnostics.AddError(
+				"Error Reading File",
+				fmt.Sprintf("Could not read file '%s' on branch '%s' of repository '%s/%s': %s", file, branch, owner, repo, err.Error()),
+			)
+			return
+		}
+
+		// The content is only decoded when the blob changed, so an unchanged
+		// file keeps the content exactly as configured
+		if contents.SHA == shas[file] {
+			continue
+		}
+		content, err := decodeFileContent(contents)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading File",
+				fmt.Sprintf("Could not decode file '%s' on branch '%s' of repository '%s/%s': %s", file, branch, owner, repo, err.Error()),
+			)
+			return
+		}
+		files[file] = content
+		shas[file] = contents.SHA
+	}
+
+	// Every file is gone, as is the branch or the repository
+	if len(files) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	var diags diag.Diagnostics
+	state.Files, diags = types.MapValueFrom(ctx, types.StringType, files)
+	resp.Diagnostics.Append(diags...)
+	state.FileShas, diags = types.MapValueFrom(ctx, types.StringType, shas)
+	resp.Diagnostics.Append(diags...)
+	state.Id = types.StringValue(fmt.Sprintf("%s/%s:%s", owner, repo, branch))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *repositoryFilesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan, state repositoryFilesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var current, shas map[string]string
+	resp.Diagnostics.Append(state.Files.ElementsAs(ctx, &current, false)...)
+	resp.Diagnostics.Append(filesShasOf(ctx, state.FileShas, &shas)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.commit(ctx, client, &plan, current, shas, state.CommitSha.ValueString())...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryFilesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryFilesResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+	branch := state.Branch.ValueString()
+
+	var current, shas map[string]string
+	resp.Diagnostics.Append(state.Files.ElementsAs(ctx, &current, false)...)
+	resp.Diagnostics.Append(filesShasOf(ctx, state.FileShas, &shas)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, httpResp, err := client.ChangeFiles(owner, repo, gitea.ChangeFilesOptions{
+		FileOptions: fileCommitOptions(branch, state.CommitMessage, state.AuthorName, state.AuthorEmail, state.CommitterName, state.CommitterEmail),
+		Files:       repositoryFilesChanges(map[string]string{}, current, shas),
+	})
+	if err != nil {
+		// If the branch or repository is already gone (404), consider it a success
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Files",
+			fmt.Sprintf("Could not delete the files on branch '%s' of repository '%s/%s': %s", branch, owner, repo, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *repositoryFilesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
+	// Import format: "owner/repository/file,file" or "owner/repository/file,file:branch"
+	owner, repo, list, branch, ok := parseRepositoryFileID(req.ID)
+	files := make(map[string]string)
+	for _, file := range strings.Split(list, ",") {
+		if file == "" {
+			ok = false
+		}
+		files[file] = ""
+	}
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in format 'owner/repository/file,file' or 'owner/repository/file,file:branch', got: %s", req.ID),
+		)
+		return
+	}
+
+	if branch == "" {
+		var err error
+		branch, err = resolveBranch(client, owner, repo, types.StringNull())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Importing Files",
+				fmt.Sprintf("Could not read the default branch of repository '%s/%s': %s", owner, repo, err.Error()),
+			)
+			return
+		}
+	}
+
+	// Read fills in the content of every file, since none of the SHAs match
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%s:%s", owner, repo, branch))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), owner)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repo)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("branch"), branch)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("files"), files)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("file_shas"), map[string]string{})...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("overwrite_on_create"), false)...)
+}
+
+// commit makes the files on the branch match the plan in a single commit,
+// given the content and SHAs of the files currently managed. When nothing
+// changed, no commit is made and the previous commit SHA is kept.
+func (r *repositoryFilesResource) commit(ctx context.Context, client *gitea.Client, plan *repositoryFilesResourceModel, current, shas map[string]string, commitSha string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+	branch := plan.Branch.ValueString()
+
+	var desired map[string]string
+	diags.Append(plan.Files.ElementsAs(ctx, &desired, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// Files new to the resource are taken over when they already exist
+	if plan.OverwriteOnCreate.ValueBool() {
+		for file := range desired {
+			if _, ok := shas[file]; ok {
+				continue
+			}
+			contents, httpResp, err := client.GetContents(owner, repo, branch, file)
+			if err != nil {
+				if httpResp != nil && httpResp.StatusCode == 404 {
+					continue
+				}
+				diags.AddError(
+					"Error Reading File",
+					fmt.Sprintf("Could not read file '%s' on branch '%s' of repository '%s/%s': %s", file, branch, owner, repo, err.Error()),
+				)
+				return diags
+			}
+			shas[file] = contents.SHA
+		}
+	}
+
+	changes := repositoryFilesChanges(desired, current, shas)
+
+	newShas := make(map[string]string)
+	for file := range desired {
+		newShas[file] = shas[file]
+	}
+
+	if len(changes) > 0 {
+		filesResp, httpResp, err := client.ChangeFiles(owner, repo, gitea.ChangeFilesOptions{
+			FileOptions: fileCommitOptions(branch, plan.CommitMessage, plan.AuthorName, plan.AuthorEmail, plan.CommitterName, plan.CommitterEmail),
+			Files:       changes,
+		})
+		if err != nil {
+			detail := fmt.Sprintf("Could not commit the files on branch '%s' of repository '%s/%s': %s", branch, owner, repo, err.Error())
+			if httpResp != nil && httpResp.StatusCode == 422 && !plan.OverwriteOnCreate.ValueBool() {
+				detail += "\n\nSet overwrite_on_create = true to take over files that already exist."
+			}
+			diags.AddError("Error Committing Files", detail)
+			return diags
+		}
+
+		// Deleted files are reported as null entries
+		for _, contents := range filesResp.Files {
+			if contents != nil {
+				newShas[contents.Path] = contents.SHA
+			}
+		}
+		commitSha = ""
+		if filesResp.Commit != nil {
+			commitSha = filesResp.Commit.SHA
+		}
+	}
+
+	var d diag.Diagnostics
+	plan.FileShas, d = types.MapValueFrom(ctx, types.StringType, newShas)
+	diags.Append(d...)
+	plan.CommitSha = types.StringValue(commitSha)
+
+	return diags
+}
+
+// repositoryFilesChanges returns the operations that turn the current files
+// into the desired ones. shas holds the blob SHA of every file that exists on
+// the branch; a desired file without one is created. Files are sorted by path
+// so the commit is stable.
+func repositoryFilesChanges(desired, current, shas map[string]string) []*gitea.ChangeFileOperation {
+	var changes []*gitea.ChangeFileOperation
+
+	for _, file := range slices.Sorted(maps.Keys(desired)) {
+		content := desired[file]
+		sha, exists := shas[file]
+		previous, managed := current[file]
+		if !exists {
+			changes = append(changes, &gitea.ChangeFileOperation{
+				Operation:     "create",
+				Path:          file,
+				ContentBase64: base64.StdEncoding.EncodeToString([]byte(content)),
+			})
+		} else if !managed || content != previous {
+			changes = append(changes, &gitea.ChangeFileOperation{
+				Operation:     "update",
+				Path:          file,
+				ContentBase64: base64.StdEncoding.EncodeToString([]byte(content)),
+				SHA:           sha,
+			})
+		}
+	}
+
+	for _, file := range slices.Sorted(maps.Keys(current)) {
+		if _, ok := desired[file]; ok {
+			continue
+		}
+		changes = append(changes, &gitea.ChangeFileOperation{
+			Operation: "delete",
+			Path:      file,
+			SHA:       shas[file],
+		})
+	}
+
+	return changes
+}
+
+// filesShasOf reads the file SHAs from state, treating a null map as empty.
+func filesShasOf(ctx context.Context, value types.Map, shas *map[string]string) diag.Diagnostics {
+	*shas = make(map[string]string)
+	if value.IsNull() || value.IsUnknown() {
+		return nil
+	}
+	return value.ElementsAs(ctx, shas, false)
+}
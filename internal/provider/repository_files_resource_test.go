@@ -0,0 +1,119 @@
+package provider
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRepositoryFilesChanges(t *testing.T) {
+	desired := map[string]string{
+		"CODEOWNERS":              "* @platform\n",
+		"README.md":               "# Service\n",
+		".gitea/workflows/ci.yml": "on: push\n",
+		"LICENSE":                 "MIT\n",
+	}
+	current := map[string]string{
+		"CODEOWNERS": "* @platform\n",
+		"README.md":  "# Old\n",
+		"OWNERS":     "platform\n",
+	}
+	shas := map[string]string{
+		"CODEOWNERS": "sha-codeowners",
+		"README.md":  "sha-readme",
+		"OWNERS":     "sha-owners",
+		"LICENSE":    "sha-license",
+	}
+
+	changes := repositoryFilesChanges(desired, current, shas)
+
+	want := []struct {
+		operation, path, content, sha string
+	}{
+		{"create", ".gitea/workflows/ci.yml", "on: push\n", ""},
+		{"update", "LICENSE", "MIT\n", "sha-license"},
+		{"update", "README.md", "# Service\n", "sha-readme"},
+		{"delete", "OWNERS", "", "sha-owners"},
+	}
+	if len(changes) != len(want) {
+		t.Fatalf("expected %d changes, got %d", len(want), len(changes))
+	}
+	for i, w := range want {
+		got := changes[i]
+		content, err := base64.StdEncoding.DecodeString(got.ContentBase64)
+		if err != nil {
+			t.Fatalf("change %d: invalid base64 content: %s", i, err)
+		}
+		if got.Operation != w.operation || got.Path != w.path || string(content) != w.content || got.SHA != w.sha {
+			t.Errorf("change %d = %s %q %q %q; want %s %q %q %q", i, got.Operation, got.Path, content, got.SHA, w.operation, w.path, w.content, w.sha)
+		}
+	}
+
+	if changes := repositoryFilesChanges(current, current, shas); len(changes) != 0 {
+		t.Errorf("expected no changes for unchanged files, got %d", len(changes))
+	}
+}
+
+func TestAccRepositoryFilesResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRepositoryFilesResourceConfig(`{
+    ".gitea/CODEOWNERS" = "* @root\n"
+    "docs/index.md"     = "# Docs\n"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_files.test", "id", "root/test-repo-files:main"),
+					resource.TestCheckResourceAttr("gitea_repository_files.test", "files.%", "2"),
+					resource.TestCheckResourceAttr("gitea_repository_files.test", "file_shas.%", "2"),
+					resource.TestCheckResourceAttrSet("gitea_repository_files.test", "commit_sha"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "gitea_repository_files.test",
+				ImportState:             true,
+				ImportStateId:           "root/test-repo-files/.gitea/CODEOWNERS,docs/index.md:main",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"commit_message", "commit_sha"},
+			},
+			// Update, add and remove files in one commit
+			{
+				Config: testAccRepositoryFilesResourceConfig(`{
+    ".gitea/CODEOWNERS" = "* @root\ndocs/ @admin\n"
+    "docs/guide.md"     = "# Guide\n"
+  }`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_files.test", "files.%", "2"),
+					resource.TestCheckResourceAttr("gitea_repository_files.test", "files..gitea/CODEOWNERS", "* @root\ndocs/ @admin\n"),
+					resource.TestCheckNoResourceAttr("gitea_repository_files.test", "files.docs/index.md"),
+					resource.TestCheckResourceAttrSet("gitea_repository_files.test", "file_shas.docs/guide.md"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryFilesResourceConfig(files string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "gitea_repository" "test" {
+  username       = "root"
+  name           = "test-repo-files"
+  private        = false
+  auto_init      = true
+  default_branch = "main"
+}
+
+resource "gitea_repository_files" "test" {
+  owner          = gitea_repository.test.username
+  repository     = gitea_repository.test.name
+  commit_message = "Manage repository files"
+  files          = %s
+}
+`, files)
+}
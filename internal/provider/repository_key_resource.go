@@ -20,13 +20,15 @@ import (
 var _ resource.Resource = (*repositoryKeyResource)(nil)
 var _ resource.ResourceWithConfigure = (*repositoryKeyResource)(nil)
 var _ resource.ResourceWithImportState = (*repositoryKeyResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*repositoryKeyResource)(nil)
 
 func NewRepositoryKeyResource() resource.Resource {
 	return &repositoryKeyResource{}
 }
 
 type repositoryKeyResource struct {
-	client *gitea.Client
+	client       *gitea.Client
+	providerData *giteaProviderData
 }
 
 type repositoryKeyResourceModel struct {
@@ -54,10 +56,11 @@ func (r *repositoryKeyResource) Schema(ctx context.Context, req resource.SchemaR
 		MarkdownDescription: "Manages a repository deploy key",
 		Attributes: map[string]schema.Attribute{
 			"owner": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Owner of the repository",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"repository": schema.StringAttribute{
@@ -108,19 +111,31 @@ func (r *repositoryKeyResource) Configure(ctx context.Context, req resource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner.
+func (r *repositoryKeyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
 }
 
 func (r *repositoryKeyResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryKeyResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -134,7 +149,7 @@ func (r *repositoryKeyResource) Create(ctx context.Context, req resource.CreateR
 		ReadOnly: data.ReadOnly.ValueBool(),
 	}
 
-	key, _, err := r.client.CreateDeployKey(data.Owner.ValueString(), data.Repo.ValueString(), opt)
+	key, _, err := client.CreateDeployKey(data.Owner.ValueString(), data.Repo.ValueString(), opt)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create repository deploy key, got error: %s", err))
 		return
@@ -150,6 +165,8 @@ func (r *repositoryKeyResource) Create(ctx context.Context, req resource.CreateR
 }
 
 func (r *repositoryKeyResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryKeyResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -157,7 +174,7 @@ func (r *repositoryKeyResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	key, httpResp, err := r.client.GetDeployKey(data.Owner.ValueString(), data.Repo.ValueString(), data.Id.ValueInt64())
+	key, httpResp, err := client.GetDeployKey(data.Owner.ValueString(), data.Repo.ValueString(), data.Id.ValueInt64())
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.State.RemoveResource(ctx)
@@ -197,6 +214,8 @@ func (r *repositoryKeyResource) Update(ctx context.Context, req resource.UpdateR
 }
 
 func (r *repositoryKeyResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryKeyResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -204,7 +223,7 @@ func (r *repositoryKeyResource) Delete(ctx context.Context, req resource.DeleteR
 		return
 	}
 
-	_, err := r.client.DeleteDeployKey(data.Owner.ValueString(), data.Repo.ValueString(), data.Id.ValueInt64())
+	_, err := client.DeleteDeployKey(data.Owner.ValueString(), data.Repo.ValueString(), data.Id.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete repository deploy key, got error: %s", err))
 		return
@@ -0,0 +1,414 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &repositoryLabelResource{}
+	_ resource.ResourceWithConfigure   = &repositoryLabelResource{}
+	_ resource.ResourceWithImportState = &repositoryLabelResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryLabelResource{}
+)
+
+// labelColorRegexp matches the hex colors accepted for labels.
+var labelColorRegexp = regexp.MustCompile(`^#?[0-9a-fA-F]{6}$`)
+
+func NewRepositoryLabelResource() resource.Resource {
+	return &repositoryLabelResource{}
+}
+
+type repositoryLabelResource struct {
+	client       *gitea.Client
+	providerData *giteaProviderData
+}
+
+type repositoryLabelResourceModel struct {
+	// Required
+	Repository types.String `tfsdk:"repository"`
+	Name       types.String `tfsdk:"name"`
+	Color      types.String `tfsdk:"color"`
+
+	// Optional
+	Owner       types.String `tfsdk:"owner"`
+	Description types.String `tfsdk:"description"`
+	Exclusive   types.Bool   `tfsdk:"exclusive"`
+	Archived    types.Bool   `tfsdk:"archived"`
+
+	// Computed
+	Id  types.Int64  `tfsdk:"id"`
+	URL types.String `tfsdk:"url"`
+}
+
+func (r *repositoryLabelResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_label"
+}
+
+func (r *repositoryLabelResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages an issue label of a Gitea repository.",
+		MarkdownDescription: "Manages an issue label of a Gitea repository. Labels shared by all repositories of an organization are managed with `gitea_org_label`.",
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the repository.",
+				MarkdownDescription: "Name of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the label. A name such as kind/bug makes the label scoped.",
+				MarkdownDescription: "Name of the label. A name such as `kind/bug` makes the label scoped.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"color": schema.StringAttribute{
+				Required:            true,
+				Description:         "Color of the label as a hex code, e.g. ee0701 or #ee0701.",
+				MarkdownDescription: "Color of the label as a hex code, e.g. `ee0701` or `#ee0701`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(labelColorRegexp, "must be a 6-digit hex color code"),
+				},
+			},
+
+			// Optional
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Owner of the repository. Defaults to the provider's default_owner.",
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "Description of the label.",
+				MarkdownDescription: "Description of the label.",
+			},
+			"exclusive": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether an issue can only have one label of the scope of this label. Only applies to scoped labels.",
+				MarkdownDescription: "Whether an issue can only have one label of the scope of this label. Only applies to scoped labels.",
+			},
+			"archived": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether the label is archived, which hides it when labeling issues. Requires Gitea 1.22 or later.",
+				MarkdownDescription: "Whether the label is archived, which hides it when labeling issues. Requires Gitea 1.22 or later.",
+			},
+
+			// Computed
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The ID of the label.",
+				MarkdownDescription: "The ID of the label.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"url": schema.StringAttribute{
+				Computed:            true,
+				Description:         "API URL of the label.",
+				MarkdownDescription: "API URL of the label.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *repositoryLabelResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*giteaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner and
+// fails planning early when archiving the label is not supported.
+func (r *repositoryLabelResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+
+	resp.Diagnostics.Append(checkLabelArchiveSupport(ctx, r.providerData, "gitea_repository_label", req)...)
+}
+
+func (r *repositoryLabelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryLabelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+
+	label, _, err := client.CreateLabel(owner, repo, gitea.CreateLabelOption{
+		Name:        plan.Name.ValueString(),
+		Color:       plan.Color.ValueString(),
+		Description: plan.Description.ValueString(),
+		Exclusive:   plan.Exclusive.ValueBool(),
+		IsArchived:  plan.Archived.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Label",
+			fmt.Sprintf("Could not create label '%s' in repository '%s/%s': %s", plan.Name.ValueString(), owner, repo, err.Error()),
+		)
+		return
+	}
+
+	plan.Id = types.Int64Value(label.ID)
+	plan.URL = types.StringValue(label.URL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryLabelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryLabelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+
+	label, httpResp, err := client.GetRepoLabel(owner, repo, state.Id.ValueInt64())
+	if err != nil {
+		// Handle 404 - label was deleted outside of Terraform
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Label",
+			fmt.Sprintf("Could not read label %d of repository '%s/%s': %s", state.Id.ValueInt64(), owner, repo, err.Error()),
+		)
+		return
+	}
+
+	state.Name = types.StringValue(label.Name)
+	state.Color = types.StringValue(labelColor(state.Color.ValueString(), label.Color))
+	state.Description = types.StringValue(label.Description)
+	state.Exclusive = types.BoolValue(label.Exclusive)
+	state.Archived = types.BoolValue(label.IsArchived)
+	state.URL = types.StringValue(label.URL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *repositoryLabelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan, state repositoryLabelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+
+	opt := gitea.EditLabelOption{
+		Name:        plan.Name.ValueStringPointer(),
+		Color:       plan.Color.ValueStringPointer(),
+		Description: plan.Description.ValueStringPointer(),
+		Exclusive:   plan.Exclusive.ValueBoolPointer(),
+	}
+	// Only sent when changed, so servers without label archiving accept the edit
+	if !plan.Archived.Equal(state.Archived) {
+		opt.IsArchived = plan.Archived.ValueBoolPointer()
+	}
+
+	label, _, err := client.EditLabel(owner, repo, state.Id.ValueInt64(), opt)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Label",
+			fmt.Sprintf("Could not update label %d of repository '%s/%s': %s", state.Id.ValueInt64(), owner, repo, err.Error()),
+		)
+		return
+	}
+
+	plan.Id = state.Id
+	plan.URL = types.StringValue(label.URL)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryLabelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryLabelResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+
+	httpResp, err := client.DeleteLabel(owner, repo, state.Id.ValueInt64())
+	if err != nil {
+		// If already deleted (404), consider it a success
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Label",
+			fmt.Sprintf("Could not delete label %d of repository '%s/%s': %s", state.Id.ValueInt64(), owner, repo, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *repositoryLabelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
+	// Import format: "owner/repository/name", where the name may be scoped
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in format 'owner/repository/name', got: %s", req.ID),
+		)
+		return
+	}
+
+	owner, repo, name := parts[0], parts[1], parts[2]
+
+	labels, err := listAllLabels(func(opt gitea.ListOptions) ([]*gitea.Label, error) {
+		labels, _, err := client.ListRepoLabels(owner, repo, gitea.ListLabelsOptions{ListOptions: opt})
+		return labels, err
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Label",
+			fmt.Sprintf("Could not list labels of repository '%s/%s': %s", owner, repo, err.Error()),
+		)
+		return
+	}
+
+	label := findLabelByName(labels, name)
+	if label == nil {
+		resp.Diagnostics.AddError(
+			"Label Not Found",
+			fmt.Sprintf("Repository '%s/%s' has no label named '%s'.", owner, repo, name),
+		)
+		return
+	}
+
+	// Read fills in the remaining attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), label.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), owner)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repo)...)
+}
+
+// checkLabelArchiveSupport fails planning when a label is to be archived on a
+// server that predates label archiving.
+func checkLabelArchiveSupport(ctx context.Context, providerData *giteaProviderData, resourceType string, req resource.ModifyPlanRequest) diag.Diagnostics {
+	var archived types.Bool
+	diags := req.Plan.GetAttribute(ctx, path.Root("archived"), &archived)
+	if diags.HasError() || !archived.ValueBool() {
+		return diags
+	}
+
+	return providerData.checkServerVersion(fmt.Sprintf("The archived attribute of the %s resource", resourceType), "1.22.0")
+}
+
+// labelColor returns the color reported by Gitea, spelled the way it is
+// configured when both denote the same color. Gitea drops the leading #.
+func labelColor(configured, reported string) string {
+	if strings.EqualFold(strings.TrimPrefix(configured, "#"), strings.TrimPrefix(reported, "#")) {
+		return configured
+	}
+	return reported
+}
+
+// listAllLabels collects the labels from every page returned by list.
+func listAllLabels(list func(gitea.ListOptions) ([]*gitea.Label, error)) ([]*gitea.Label, error) {
+	var all []*gitea.Label
+
+	page := 1
+	for {
+		labels, err := list(gitea.ListOptions{
+			Page:     page,
+			PageSize: 50, // Use default max page size
+		})
+		if err != nil {
+			return nil, err
+		}
+
+		all = append(all, labels...)
+
+		// No more results if we got fewer than page size
+		if len(labels) < 50 {
+			break
+		}
+
+		page++
+	}
+
+	return all, nil
+}
+
+// findLabelByName returns the label called name, or nil if there is none.
+func findLabelByName(labels []*gitea.Label, name string) *gitea.Label {
+	for _, label := range labels {
+		if label.Name == name {
+			return label
+		}
+	}
+	return nil
+}
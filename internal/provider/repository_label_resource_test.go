@@ -0,0 +1,82 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestLabelColor(t *testing.T) {
+	cases := []struct {
+		configured, reported, want string
+	}{
+		{"#ee0701", "ee0701", "#ee0701"},
+		{"EE0701", "ee0701", "EE0701"},
+		{"ee0701", "ee0701", "ee0701"},
+		{"#ee0701", "00aabb", "00aabb"},
+		{"", "00aabb", "00aabb"},
+	}
+	for _, c := range cases {
+		if got := labelColor(c.configured, c.reported); got != c.want {
+			t.Errorf("labelColor(%q, %q) = %q, want %q", c.configured, c.reported, got, c.want)
+		}
+	}
+}
+
+func TestAccRepositoryLabelResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRepositoryLabelResourceConfig("kind/bug", "#ee0701", "Something is not working"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_label.test", "name", "kind/bug"),
+					resource.TestCheckResourceAttr("gitea_repository_label.test", "color", "#ee0701"),
+					resource.TestCheckResourceAttr("gitea_repository_label.test", "exclusive", "true"),
+					resource.TestCheckResourceAttr("gitea_repository_label.test", "archived", "false"),
+					resource.TestCheckResourceAttrSet("gitea_repository_label.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "gitea_repository_label.test",
+				ImportState:       true,
+				ImportStateId:     "root/test-repo-labels/kind/bug",
+				ImportStateVerify: true,
+				// Gitea reports the color without the leading #
+				ImportStateVerifyIgnore: []string{"color"},
+			},
+			// Update and Read testing
+			{
+				Config: testAccRepositoryLabelResourceConfig("kind/defect", "00aabb", "Renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_label.test", "name", "kind/defect"),
+					resource.TestCheckResourceAttr("gitea_repository_label.test", "color", "00aabb"),
+					resource.TestCheckResourceAttr("gitea_repository_label.test", "description", "Renamed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryLabelResourceConfig(name, color, description string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "gitea_repository" "test" {
+  username = "root"
+  name     = "test-repo-labels"
+  private  = false
+}
+
+resource "gitea_repository_label" "test" {
+  owner       = gitea_repository.test.username
+  repository  = gitea_repository.test.name
+  name        = %[1]q
+  color       = %[2]q
+  description = %[3]q
+  exclusive   = true
+}
+`, name, color, description)
+}
@@ -0,0 +1,396 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &repositoryMilestoneResource{}
+	_ resource.ResourceWithConfigure   = &repositoryMilestoneResource{}
+	_ resource.ResourceWithImportState = &repositoryMilestoneResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryMilestoneResource{}
+)
+
+// milestoneDueDateLayout is the format of the due_date attribute. Only the day
+// is managed, the deadline sent to Gitea is the end of that day in UTC.
+const milestoneDueDateLayout = "2006-01-02"
+
+// milestoneNoDueDateYear is the year of the placeholder deadline Gitea stores
+// for a milestone without a due date.
+const milestoneNoDueDateYear = 9999
+
+var milestoneDueDateRegexp = regexp.MustCompile(`^\d{4}-\d{2}-\d{2}$`)
+
+func NewRepositoryMilestoneResource() resource.Resource {
+	return &repositoryMilestoneResource{}
+}
+
+type repositoryMilestoneResource struct {
+	client       *gitea.Client
+	providerData *giteaProviderData
+}
+
+type repositoryMilestoneResourceModel struct {
+	// Required
+	Repository types.String `tfsdk:"repository"`
+	Title      types.String `tfsdk:"title"`
+
+	// Optional
+	Owner       types.String `tfsdk:"owner"`
+	Description types.String `tfsdk:"description"`
+	DueDate     types.String `tfsdk:"due_date"`
+	State       types.String `tfsdk:"state"`
+
+	// Computed
+	Id           types.Int64  `tfsdk:"id"`
+	OpenIssues   types.Int64  `tfsdk:"open_issues"`
+	ClosedIssues types.Int64  `tfsdk:"closed_issues"`
+	ClosedAt     types.String `tfsdk:"closed_at"`
+}
+
+func (r *repositoryMilestoneResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_milestone"
+}
+
+func (r *repositoryMilestoneResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a milestone of a Gitea repository.",
+		MarkdownDescription: "Manages a milestone of a Gitea repository. This resource allows you to create, update, close and delete milestones.",
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the repository.",
+				MarkdownDescription: "Name of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"title": schema.StringAttribute{
+				Required:            true,
+				Description:         "Title of the milestone.",
+				MarkdownDescription: "Title of the milestone.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Optional
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Owner of the repository. Defaults to the provider's default_owner.",
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"description": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "Description of the milestone.",
+				MarkdownDescription: "Description of the milestone.",
+			},
+			"due_date": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Day the milestone is due, in the format YYYY-MM-DD. The milestone is due at the end of that day, UTC.",
+				MarkdownDescription: "Day the milestone is due, in the format `YYYY-MM-DD`. The milestone is due at the end of that day, UTC.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(milestoneDueDateRegexp, "must be a date in the format YYYY-MM-DD"),
+				},
+			},
+			"state": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(string(gitea.StateOpen)),
+				Description:         "State of the milestone: open or closed. Defaults to open.",
+				MarkdownDescription: "State of the milestone: `open` or `closed`. Defaults to `open`.",
+				Validators: []validator.String{
+					stringvalidator.OneOf(string(gitea.StateOpen), string(gitea.StateClosed)),
+				},
+			},
+
+			// Computed
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The ID of the milestone.",
+				MarkdownDescription: "The ID of the milestone.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"open_issues": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "Number of open issues and pull requests in the milestone.",
+				MarkdownDescription: "Number of open issues and pull requests in the milestone.",
+			},
+			"closed_issues": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "Number of closed issues and pull requests in the milestone.",
+				MarkdownDescription: "Number of closed issues and pull requests in the milestone.",
+			},
+			"closed_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Timestamp when the milestone was closed, empty while it is open.",
+				MarkdownDescription: "Timestamp when the milestone was closed, empty while it is open.",
+			},
+		},
+	}
+}
+
+func (r *repositoryMilestoneResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*giteaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner.
+func (r *repositoryMilestoneResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+}
+
+func (r *repositoryMilestoneResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryMilestoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deadline, err := parseMilestoneDueDate(plan.DueDate)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("due_date"), "Invalid Due Date", err.Error())
+		return
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+
+	milestone, _, err := client.CreateMilestone(owner, repo, gitea.CreateMilestoneOption{
+		Title:       plan.Title.ValueString(),
+		Description: plan.Description.ValueString(),
+		State:       gitea.StateType(plan.State.ValueString()),
+		Deadline:    deadline,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Milestone",
+			fmt.Sprintf("Could not create milestone '%s' in repository '%s/%s': %s", plan.Title.ValueString(), owner, repo, err.Error()),
+		)
+		return
+	}
+
+	setMilestoneComputed(&plan, milestone)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryMilestoneResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryMilestoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+
+	milestone, httpResp, err := client.GetMilestone(owner, repo, state.Id.ValueInt64())
+	if err != nil {
+		// Handle 404 - milestone was deleted outside of Terraform
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Milestone",
+			fmt.Sprintf("Could not read milestone %d of repository '%s/%s': %s", state.Id.ValueInt64(), owner, repo, err.Error()),
+		)
+		return
+	}
+
+	state.Title = types.StringValue(milestone.Title)
+	state.Description = types.StringValue(milestone.Description)
+	state.State = types.StringValue(string(milestone.State))
+	state.DueDate = types.StringNull()
+	if milestone.Deadline != nil && milestone.Deadline.Year() < milestoneNoDueDateYear {
+		state.DueDate = types.StringValue(milestone.Deadline.UTC().Format(milestoneDueDateLayout))
+	}
+	setMilestoneComputed(&state, milestone)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *repositoryMilestoneResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan, state repositoryMilestoneResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	deadline, err := parseMilestoneDueDate(plan.DueDate)
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("due_date"), "Invalid Due Date", err.Error())
+		return
+	}
+	// Gitea keeps the due date when none is sent, so removing it is explicit
+	if deadline == nil && !state.DueDate.IsNull() {
+		noDueDate := time.Date(milestoneNoDueDateYear, 12, 31, 23, 59, 59, 0, time.UTC)
+		deadline = &noDueDate
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+	milestoneState := gitea.StateType(plan.State.ValueString())
+
+	milestone, _, err := client.EditMilestone(owner, repo, state.Id.ValueInt64(), gitea.EditMilestoneOption{
+		Title:       plan.Title.ValueString(),
+		Description: plan.Description.ValueStringPointer(),
+		State:       &milestoneState,
+		Deadline:    deadline,
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Milestone",
+			fmt.Sprintf("Could not update milestone %d of repository '%s/%s': %s", state.Id.ValueInt64(), owner, repo, err.Error()),
+		)
+		return
+	}
+
+	setMilestoneComputed(&plan, milestone)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryMilestoneResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryMilestoneResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+
+	httpResp, err := client.DeleteMilestone(owner, repo, state.Id.ValueInt64())
+	if err != nil {
+		// If already deleted (404), consider it a success
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Milestone",
+			fmt.Sprintf("Could not delete milestone %d of repository '%s/%s': %s", state.Id.ValueInt64(), owner, repo, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *repositoryMilestoneResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
+	// Import format: "owner/repository/title", where the title may contain slashes
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in format 'owner/repository/title', got: %s", req.ID),
+		)
+		return
+	}
+
+	owner, repo, title := parts[0], parts[1], parts[2]
+
+	milestone, httpResp, err := client.GetMilestoneByName(owner, repo, title)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.Diagnostics.AddError(
+				"Milestone Not Found",
+				fmt.Sprintf("Repository '%s/%s' has no milestone titled '%s'.", owner, repo, title),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Importing Milestone",
+			fmt.Sprintf("Could not read milestone '%s' of repository '%s/%s': %s", title, owner, repo, err.Error()),
+		)
+		return
+	}
+
+	// Read fills in the remaining attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), milestone.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), owner)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repo)...)
+}
+
+// parseMilestoneDueDate converts the due_date attribute to the deadline sent
+// to Gitea, the end of that day in UTC. A null due date gives a nil deadline.
+func parseMilestoneDueDate(dueDate types.String) (*time.Time, error) {
+	if dueDate.IsNull() || dueDate.IsUnknown() {
+		return nil, nil
+	}
+
+	day, err := time.Parse(milestoneDueDateLayout, dueDate.ValueString())
+	if err != nil {
+		return nil, fmt.Errorf("could not parse due date '%s': %w", dueDate.ValueString(), err)
+	}
+
+	deadline := day.Add(24*time.Hour - time.Second)
+	return &deadline, nil
+}
+
+// setMilestoneComputed copies the attributes Gitea computes from milestone.
+func setMilestoneComputed(model *repositoryMilestoneResourceModel, milestone *gitea.Milestone) {
+	model.Id = types.Int64Value(milestone.ID)
+	model.OpenIssues = types.Int64Value(int64(milestone.OpenIssues))
+	model.ClosedIssues = types.Int64Value(int64(milestone.ClosedIssues))
+	model.ClosedAt = types.StringValue("")
+	if milestone.Closed != nil && !milestone.Closed.IsZero() {
+		model.ClosedAt = types.StringValue(milestone.Closed.Format("2006-01-02T15:04:05Z07:00"))
+	}
+}
@@ -0,0 +1,86 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestParseMilestoneDueDate(t *testing.T) {
+	deadline, err := parseMilestoneDueDate(types.StringValue("2026-03-31"))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if want := time.Date(2026, 3, 31, 23, 59, 59, 0, time.UTC); !deadline.Equal(want) {
+		t.Errorf("expected the end of the day, got %s", deadline)
+	}
+	if got := deadline.UTC().Format(milestoneDueDateLayout); got != "2026-03-31" {
+		t.Errorf("expected the deadline to read back as the same day, got %s", got)
+	}
+
+	if deadline, err := parseMilestoneDueDate(types.StringNull()); err != nil || deadline != nil {
+		t.Errorf("expected no deadline for a null due date, got %v, %v", deadline, err)
+	}
+
+	if _, err := parseMilestoneDueDate(types.StringValue("2026-02-30")); err == nil {
+		t.Error("expected an error for an invalid date")
+	}
+}
+
+func TestAccRepositoryMilestoneResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRepositoryMilestoneResourceConfig("v1.0", `due_date = "2030-06-30"`, "open"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_milestone.test", "title", "v1.0"),
+					resource.TestCheckResourceAttr("gitea_repository_milestone.test", "due_date", "2030-06-30"),
+					resource.TestCheckResourceAttr("gitea_repository_milestone.test", "state", "open"),
+					resource.TestCheckResourceAttr("gitea_repository_milestone.test", "closed_at", ""),
+					resource.TestCheckResourceAttrSet("gitea_repository_milestone.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "gitea_repository_milestone.test",
+				ImportState:       true,
+				ImportStateId:     "root/test-repo-milestones/v1.0",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing: close the milestone and drop the due date
+			{
+				Config: testAccRepositoryMilestoneResourceConfig("v1.0.0", "", "closed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_milestone.test", "title", "v1.0.0"),
+					resource.TestCheckNoResourceAttr("gitea_repository_milestone.test", "due_date"),
+					resource.TestCheckResourceAttr("gitea_repository_milestone.test", "state", "closed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryMilestoneResourceConfig(title, dueDate, state string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "gitea_repository" "test" {
+  username = "root"
+  name     = "test-repo-milestones"
+  private  = false
+}
+
+resource "gitea_repository_milestone" "test" {
+  owner       = gitea_repository.test.username
+  repository  = gitea_repository.test.name
+  title       = %[1]q
+  description = "First release train"
+  state       = %[3]q
+  %[2]s
+}
+`, title, dueDate, state)
+}
@@ -0,0 +1,474 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &repositoryPushMirrorResource{}
+	_ resource.ResourceWithConfigure   = &repositoryPushMirrorResource{}
+	_ resource.ResourceWithImportState = &repositoryPushMirrorResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryPushMirrorResource{}
+)
+
+// pushMirrorIntervalRegexp matches the durations Gitea accepts as a sync
+// interval, such as 8h or 1h30m.
+var pushMirrorIntervalRegexp = regexp.MustCompile(`^([0-9]+(h|m|s))+$`)
+
+func NewRepositoryPushMirrorResource() resource.Resource {
+	return &repositoryPushMirrorResource{}
+}
+
+type repositoryPushMirrorResource struct {
+	client       *gitea.Client
+	providerData *giteaProviderData
+}
+
+type repositoryPushMirrorResourceModel struct {
+	// Required
+	Repository    types.String `tfsdk:"repository"`
+	RemoteAddress types.String `tfsdk:"remote_address"`
+
+	// Optional
+	Owner          types.String `tfsdk:"owner"`
+	RemoteUsername types.String `tfsdk:"remote_username"`
+	RemotePassword types.String `tfsdk:"remote_password"`
+	Interval       types.String `tfsdk:"interval"`
+	SyncOnCommit   types.Bool   `tfsdk:"sync_on_commit"`
+	SyncTrigger    types.String `tfsdk:"sync_trigger"`
+
+	// Computed
+	Id         types.String `tfsdk:"id"`
+	RemoteName types.String `tfsdk:"remote_name"`
+	CreatedAt  types.String `tfsdk:"created_at"`
+	LastUpdate types.String `tfsdk:"last_update"`
+	LastError  types.String `tfsdk:"last_error"`
+}
+
+func (r *repositoryPushMirrorResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_push_mirror"
+}
+
+func (r *repositoryPushMirrorResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a push mirror of a Gitea repository.",
+		MarkdownDescription: "Manages a push mirror of a Gitea repository. Gitea pushes all branches and tags of the repository to the remote on a schedule and, optionally, after every commit. Push mirrors cannot be edited, so changing any attribute other than `sync_trigger` replaces the mirror.",
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the repository.",
+				MarkdownDescription: "Name of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote_address": schema.StringAttribute{
+				Required:            true,
+				Description:         "URL of the remote repository to push to.",
+				MarkdownDescription: "URL of the remote repository to push to.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Optional
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Owner of the repository. Defaults to the provider's default_owner.",
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"remote_username": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Username to authenticate with at the remote.",
+				MarkdownDescription: "Username to authenticate with at the remote.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"remote_password": schema.StringAttribute{
+				Optional:            true,
+				Sensitive:           true,
+				Description:         "Password or access token to authenticate with at the remote. Gitea does not return it, so changes made outside of Terraform are not detected.",
+				MarkdownDescription: "Password or access token to authenticate with at the remote. Gitea does not return it, so changes made outside of Terraform are not detected.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"interval": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString("8h0m0s"),
+				Description:         "Interval between scheduled pushes, such as 8h or 1h30m. 0s disables scheduled pushes. Defaults to 8h0m0s.",
+				MarkdownDescription: "Interval between scheduled pushes, such as `8h` or `1h30m`. `0s` disables scheduled pushes. Defaults to `8h0m0s`.",
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(pushMirrorIntervalRegexp, "must be a duration in hours, minutes and seconds, such as 8h or 1h30m"),
+				},
+				PlanModifiers: []planmodifier.String{
+					pushMirrorIntervalModifier{},
+				},
+			},
+			"sync_on_commit": schema.BoolAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+				Description:         "Whether to push to the remote after every commit. Defaults to false.",
+				MarkdownDescription: "Whether to push to the remote after every commit. Defaults to `false`.",
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"sync_trigger": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Arbitrary value that pushes the repository to its push mirrors right away whenever it changes, for example a timestamp or a release version. Gitea pushes all push mirrors of the repository at once.",
+				MarkdownDescription: "Arbitrary value that pushes the repository to its push mirrors right away whenever it changes, for example a timestamp or a release version. Gitea pushes all push mirrors of the repository at once.",
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the push mirror in the format owner/repository/remote_name.",
+				MarkdownDescription: "The ID of the push mirror in the format `owner/repository/remote_name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"remote_name": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Name of the git remote Gitea created for the mirror.",
+				MarkdownDescription: "Name of the git remote Gitea created for the mirror.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Timestamp when the push mirror was created.",
+				MarkdownDescription: "Timestamp when the push mirror was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_update": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Timestamp of the last push to the remote.",
+				MarkdownDescription: "Timestamp of the last push to the remote.",
+			},
+			"last_error": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Error of the last push to the remote, empty when it succeeded.",
+				MarkdownDescription: "Error of the last push to the remote, empty when it succeeded.",
+			},
+		},
+	}
+}
+
+func (r *repositoryPushMirrorResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*giteaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner.
+func (r *repositoryPushMirrorResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+}
+
+func (r *repositoryPushMirrorResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryPushMirrorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+
+	mirror, _, err := client.PushMirrors(owner, repo, gitea.CreatePushMirrorOption{
+		RemoteAddress:  plan.RemoteAddress.ValueString(),
+		RemoteUsername: plan.RemoteUsername.ValueString(),
+		RemotePassword: plan.RemotePassword.ValueString(),
+		Interval:       plan.Interval.ValueString(),
+		SyncONCommit:   plan.SyncOnCommit.ValueBool(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Push Mirror",
+			fmt.Sprintf("Could not create push mirror to '%s' for repository '%s/%s': %s", plan.RemoteAddress.ValueString(), owner, repo, err.Error()),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", owner, repo, mirror.RemoteName))
+	setPushMirrorComputed(&plan, mirror)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.SyncTrigger.IsNull() {
+		r.syncPushMirrors(ctx, client, &plan, resp.Diagnostics.AddError)
+		resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	}
+}
+
+func (r *repositoryPushMirrorResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryPushMirrorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+	remoteName := state.RemoteName.ValueString()
+
+	mirror, httpResp, err := client.GetPushMirrorByRemoteName(owner, repo, remoteName)
+	if err != nil {
+		// Handle 404 - push mirror was deleted outside of Terraform
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Push Mirror",
+			fmt.Sprintf("Could not read push mirror '%s' of repository '%s/%s': %s", remoteName, owner, repo, err.Error()),
+		)
+		return
+	}
+
+	// Gitea cannot change the address of a push mirror and may report it in
+	// a normalized form, so it is only read when importing
+	if state.RemoteAddress.IsNull() {
+		state.RemoteAddress = types.StringValue(mirror.RemoteAddress)
+	}
+	state.Interval = types.StringValue(pushMirrorInterval(state.Interval.ValueString(), mirror.Interval))
+	state.SyncOnCommit = types.BoolValue(mirror.SyncONCommit)
+	setPushMirrorComputed(&state, mirror)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only handles sync_trigger and a new spelling of the interval, every
+// other change requires replacement.
+func (r *repositoryPushMirrorResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan, state repositoryPushMirrorResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdate = state.LastUpdate
+	plan.LastError = state.LastError
+
+	if !plan.SyncTrigger.IsNull() && !plan.SyncTrigger.Equal(state.SyncTrigger) {
+		r.syncPushMirrors(ctx, client, &plan, resp.Diagnostics.AddError)
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryPushMirrorResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryPushMirrorResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+	remoteName := state.RemoteName.ValueString()
+
+	httpResp, err := client.DeletePushMirror(owner, repo, remoteName)
+	if err != nil {
+		// If already deleted (404), consider it a success
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Push Mirror",
+			fmt.Sprintf("Could not delete push mirror '%s' of repository '%s/%s': %s", remoteName, owner, repo, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *repositoryPushMirrorResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: "owner/repository/remote_name"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in format 'owner/repository/remote_name', got: %s", req.ID),
+		)
+		return
+	}
+
+	// Read fills in the remaining attributes. The credentials cannot be read
+	// back and stay empty until they are configured.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), parts[1])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("remote_name"), parts[2])...)
+}
+
+// syncPushMirrors asks Gitea to push the repository of model to its push
+// mirrors and refreshes the sync status in model. Gitea pushes in the
+// background, so the status usually still shows the previous push.
+func (r *repositoryPushMirrorResource) syncPushMirrors(ctx context.Context, client *gitea.Client, model *repositoryPushMirrorResourceModel, addError func(summary, detail string)) {
+	owner := model.Owner.ValueString()
+	repo := model.Repository.ValueString()
+
+	if _, err := client.SyncPushMirrors(owner, repo); err != nil {
+		addError(
+			"Error Syncing Push Mirror",
+			fmt.Sprintf("Could not sync the push mirrors of repository '%s/%s': %s", owner, repo, err.Error()),
+		)
+		return
+	}
+
+	mirror, _, err := client.GetPushMirrorByRemoteName(owner, repo, model.RemoteName.ValueString())
+	if err != nil {
+		addError(
+			"Error Reading Push Mirror",
+			fmt.Sprintf("Could not read push mirror '%s' of repository '%s/%s': %s", model.RemoteName.ValueString(), owner, repo, err.Error()),
+		)
+		return
+	}
+	setPushMirrorComputed(model, mirror)
+}
+
+// pushMirrorInterval returns the interval reported by Gitea, spelled the way
+// it is configured when both denote the same duration. Gitea reports
+// intervals in the long form, such as 8h0m0s for 8h, which is shortened
+// otherwise so an imported mirror matches the usual spelling.
+func pushMirrorInterval(configured, reported string) string {
+	reportedDuration, err := time.ParseDuration(reported)
+	if err != nil {
+		return reported
+	}
+	if configuredDuration, err := time.ParseDuration(configured); err == nil && configuredDuration == reportedDuration {
+		return configured
+	}
+	return shortDuration(reportedDuration)
+}
+
+// shortDuration formats d in whole hours, minutes and seconds, leaving out
+// the units that are zero, such as 1h30m instead of 1h30m0s.
+func shortDuration(d time.Duration) string {
+	if d == 0 {
+		return "0s"
+	}
+
+	var b strings.Builder
+	if h := d / time.Hour; h > 0 {
+		fmt.Fprintf(&b, "%dh", h)
+	}
+	if m := d % time.Hour / time.Minute; m > 0 {
+		fmt.Fprintf(&b, "%dm", m)
+	}
+	if s := d % time.Minute / time.Second; s > 0 {
+		fmt.Fprintf(&b, "%ds", s)
+	}
+	return b.String()
+}
+
+// pushMirrorIntervalModifier replaces the push mirror only when the interval
+// changes to a different duration. A new spelling of the same duration, such
+// as 1h for 1h0m0s, is an in-place update, and an omitted interval keeps the
+// spelling in state when it equals the default.
+type pushMirrorIntervalModifier struct{}
+
+func (m pushMirrorIntervalModifier) Description(_ context.Context) string {
+	return "Requires replacement when the interval changes to a different duration."
+}
+
+func (m pushMirrorIntervalModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+func (m pushMirrorIntervalModifier) PlanModifyString(_ context.Context, req planmodifier.StringRequest, resp *planmodifier.StringResponse) {
+	// Nothing to compare on create and destroy
+	if req.State.Raw.IsNull() || req.Plan.Raw.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	planned, err := time.ParseDuration(req.PlanValue.ValueString())
+	if err != nil {
+		resp.RequiresReplace = !req.PlanValue.Equal(req.StateValue)
+		return
+	}
+	prior, err := time.ParseDuration(req.StateValue.ValueString())
+	if err != nil || planned != prior {
+		resp.RequiresReplace = true
+		return
+	}
+
+	// A planned value must match the configuration, so only the default can
+	// be swapped for the spelling in state
+	if req.ConfigValue.IsNull() {
+		resp.PlanValue = req.StateValue
+	}
+}
+
+// setPushMirrorComputed copies the attributes Gitea computes from mirror.
+func setPushMirrorComputed(model *repositoryPushMirrorResourceModel, mirror *gitea.PushMirrorResponse) {
+	model.RemoteName = types.StringValue(mirror.RemoteName)
+	model.CreatedAt = types.StringValue(mirror.Created)
+	model.LastUpdate = types.StringValue(mirror.LastUpdate)
+	model.LastError = types.StringValue(mirror.LastError)
+}
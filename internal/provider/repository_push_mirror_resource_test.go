@@ -0,0 +1,134 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestPushMirrorInterval(t *testing.T) {
+	cases := []struct {
+		configured, reported, want string
+	}{
+		{"8h", "8h0m0s", "8h"},
+		{"1h30m", "1h30m0s", "1h30m"},
+		{"0s", "0s", "0s"},
+		{"8h", "12h0m0s", "12h"},
+		{"", "8h0m0s", "8h"},
+		{"", "1h30m0s", "1h30m"},
+		{"", "0s", "0s"},
+		{"60m", "1h0m0s", "60m"},
+		{"", "invalid", "invalid"},
+	}
+
+	for _, c := range cases {
+		if got := pushMirrorInterval(c.configured, c.reported); got != c.want {
+			t.Errorf("pushMirrorInterval(%q, %q) = %q, want %q", c.configured, c.reported, got, c.want)
+		}
+	}
+}
+
+func TestPushMirrorIntervalModifier(t *testing.T) {
+	existing := tftypes.NewValue(tftypes.Object{}, map[string]tftypes.Value{})
+
+	cases := []struct {
+		config, plan, state string
+		wantPlan            string
+		wantReplace         bool
+	}{
+		{"1h", "1h", "1h0m0s", "1h", false},
+		{"60m", "60m", "1h", "60m", false},
+		{"2h", "2h", "1h0m0s", "2h", true},
+		{"", "8h0m0s", "8h", "8h", false},
+		{"", "8h0m0s", "1h", "8h0m0s", true},
+	}
+
+	for _, c := range cases {
+		config := types.StringNull()
+		if c.config != "" {
+			config = types.StringValue(c.config)
+		}
+		req := planmodifier.StringRequest{
+			State:       tfsdk.State{Raw: existing},
+			Plan:        tfsdk.Plan{Raw: existing},
+			ConfigValue: config,
+			PlanValue:   types.StringValue(c.plan),
+			StateValue:  types.StringValue(c.state),
+		}
+		resp := &planmodifier.StringResponse{PlanValue: req.PlanValue}
+		pushMirrorIntervalModifier{}.PlanModifyString(context.Background(), req, resp)
+
+		if resp.PlanValue.ValueString() != c.wantPlan || resp.RequiresReplace != c.wantReplace {
+			t.Errorf("config %q, plan %q, state %q: got plan %q and replace %v, want %q and %v",
+				c.config, c.plan, c.state, resp.PlanValue.ValueString(), resp.RequiresReplace, c.wantPlan, c.wantReplace)
+		}
+	}
+}
+
+func TestAccRepositoryPushMirrorResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRepositoryPushMirrorResourceConfig("first"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_push_mirror.test", "remote_address", "http://localhost:3000/root/test-repo-push-mirror-target.git"),
+					resource.TestCheckResourceAttr("gitea_repository_push_mirror.test", "interval", "1h"),
+					resource.TestCheckResourceAttr("gitea_repository_push_mirror.test", "sync_on_commit", "true"),
+					resource.TestCheckResourceAttrSet("gitea_repository_push_mirror.test", "remote_name"),
+					resource.TestCheckResourceAttrSet("gitea_repository_push_mirror.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "gitea_repository_push_mirror.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"remote_username", "remote_password", "sync_trigger", "last_update", "last_error"},
+			},
+			// Update testing: a new trigger value syncs the mirror in place
+			{
+				Config: testAccRepositoryPushMirrorResourceConfig("second"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_push_mirror.test", "sync_trigger", "second"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryPushMirrorResourceConfig(syncTrigger string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "gitea_repository" "test" {
+  username  = "root"
+  name      = "test-repo-push-mirror"
+  private   = false
+  auto_init = true
+}
+
+resource "gitea_repository" "target" {
+  username = "root"
+  name     = "test-repo-push-mirror-target"
+  private  = false
+}
+
+resource "gitea_repository_push_mirror" "test" {
+  owner           = gitea_repository.test.username
+  repository      = gitea_repository.test.name
+  remote_address  = "http://localhost:3000/root/${gitea_repository.target.name}.git"
+  remote_username = "root"
+  remote_password = "admin1234"
+  interval        = "1h"
+  sync_on_commit  = true
+  sync_trigger    = %q
+}
+`, syncTrigger)
+}
@@ -7,6 +7,7 @@ import (
 
 	"code.gitea.io/sdk/gitea"
 	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
@@ -22,6 +23,7 @@ var (
 	_ resource.Resource                = &repositoryResource{}
 	_ resource.ResourceWithConfigure   = &repositoryResource{}
 	_ resource.ResourceWithImportState = &repositoryResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryResource{}
 )
 
 func NewRepositoryResource() resource.Resource {
@@ -29,7 +31,8 @@ func NewRepositoryResource() resource.Resource {
 }
 
 type repositoryResource struct {
-	client *gitea.Client
+	client       *gitea.Client
+	providerData *giteaProviderData
 }
 
 type repositoryResourceModel struct {
@@ -109,11 +112,12 @@ func (r *repositoryResource) Schema(ctx context.Context, _ resource.SchemaReques
 		Attributes: map[string]schema.Attribute{
 			// ==================== REQUIRED ====================
 			"username": schema.StringAttribute{
-				Required:            true,
-				Description:         "The owner of the repository.",
-				MarkdownDescription: "The owner of the repository.",
+				Optional:            true,
+				Computed:            true,
+				Description:         "The owner of the repository. Defaults to the provider's default_owner.",
+				MarkdownDescription: "The owner of the repository. Defaults to the provider's `default_owner`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"name": schema.StringAttribute{
@@ -535,19 +539,67 @@ func (r *repositoryResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted username from the provider's default_owner. It
+// then fails planning early when creating the repository would need
+// AdminCreateRepo (the owner is neither an organization nor the authenticated
+// user) and the authenticated user is not a site administrator.
+func (r *repositoryResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("username"), req, resp)
+
+	// Only creation can hit an admin endpoint
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || !req.State.Raw.IsNull() {
+		return
+	}
+
+	if r.providerData.currentUser.IsAdmin {
+		return
+	}
+
+	var plan repositoryResourceModel
+	resp.Diagnostics.Append(resp.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Migrations go through MigrateRepo, and unknown owners are checked at apply
+	if plan.Username.IsUnknown() ||
+		plan.MigrationCloneAddress.ValueString() != "" ||
+		plan.MigrationCloneAddresse.ValueString() != "" {
+		return
+	}
+
+	username := plan.Username.ValueString()
+	if username == r.providerData.currentUser.UserName {
+		return
+	}
+
+	if org, _, err := r.client.WithContext(ctx).GetOrg(username); err == nil && org != nil {
+		return
+	}
+
+	resp.Diagnostics.Append(r.providerData.checkAdminPrivilege("gitea_repository", "AdminCreateRepo (POST /admin/users/{username}/repos)")...)
 }
 
 func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan repositoryResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -592,7 +644,7 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 			migrateOpts.MirrorInterval = plan.MigrationMirrorInterval.ValueString()
 		}
 
-		repo, _, err = r.client.MigrateRepo(migrateOpts)
+		repo, _, err = client.MigrateRepo(migrateOpts)
 	} else {
 		// Create repository
 		createOpts := gitea.CreateRepoOption{
@@ -609,13 +661,13 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 		}
 
 		// Check if owner is an org or user and use appropriate API
-		org, _, orgErr := r.client.GetOrg(username)
+		org, _, orgErr := client.GetOrg(username)
 		if orgErr == nil && org != nil {
 			// Owner is an organization
-			repo, _, err = r.client.CreateOrgRepo(username, createOpts)
+			repo, _, err = client.CreateOrgRepo(username, createOpts)
 		} else {
 			// Check if the owner is the currently authenticated user
-			currentUser, _, userErr := r.client.GetMyUserInfo()
+			currentUser, _, userErr := client.GetMyUserInfo()
 			if userErr != nil {
 				resp.Diagnostics.AddError(
 					"Error Getting Current User",
@@ -626,10 +678,10 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 
 			if currentUser.UserName == username {
 				// Owner is the current user
-				repo, _, err = r.client.CreateRepo(createOpts)
+				repo, _, err = client.CreateRepo(createOpts)
 			} else {
 				// Try to create for another user (admin only)
-				repo, _, err = r.client.AdminCreateRepo(username, createOpts)
+				repo, _, err = client.AdminCreateRepo(username, createOpts)
 			}
 		}
 	}
@@ -650,7 +702,7 @@ func (r *repositoryResource) Create(ctx context.Context, req resource.CreateRequ
 	// If additional edit-only settings were specified, apply them now
 	if needsPostCreateUpdate(&desired) {
 		editOpts := buildEditRepoOption(ctx, &desired)
-		repo, _, err = r.client.EditRepo(username, repo.Name, editOpts)
+		repo, _, err = client.EditRepo(username, repo.Name, editOpts)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating Repository After Creation",
@@ -767,6 +819,8 @@ func buildEditRepoOption(ctx context.Context, plan *repositoryResourceModel) git
 }
 
 func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state repositoryResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -777,7 +831,7 @@ func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 	username := state.Username.ValueString()
 	repoName := state.Name.ValueString()
 
-	repo, httpResp, err := r.client.GetRepo(username, repoName)
+	repo, httpResp, err := client.GetRepo(username, repoName)
 	if err != nil {
 		// Handle 404 gracefully - remove from state
 		if httpResp != nil && httpResp.StatusCode == 404 {
@@ -847,6 +901,8 @@ func (r *repositoryResource) Read(ctx context.Context, req resource.ReadRequest,
 }
 
 func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan repositoryResourceModel
 	var state repositoryResourceModel
 
@@ -861,7 +917,7 @@ func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 
 	editOpts := buildEditRepoOption(ctx, &plan)
 
-	repo, _, err := r.client.EditRepo(username, repoName, editOpts)
+	repo, _, err := client.EditRepo(username, repoName, editOpts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Repository",
@@ -918,6 +974,8 @@ func (r *repositoryResource) Update(ctx context.Context, req resource.UpdateRequ
 }
 
 func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state repositoryResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -934,7 +992,7 @@ func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 		editOpts := gitea.EditRepoOption{
 			Archived: &archived,
 		}
-		_, _, err := r.client.EditRepo(username, repoName, editOpts)
+		_, _, err := client.EditRepo(username, repoName, editOpts)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Archiving Repository",
@@ -945,7 +1003,7 @@ func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
-	_, err := r.client.DeleteRepo(username, repoName)
+	_, err := client.DeleteRepo(username, repoName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Repository",
@@ -956,6 +1014,8 @@ func (r *repositoryResource) Delete(ctx context.Context, req resource.DeleteRequ
 }
 
 func (r *repositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
 	// Import format: "username/repo"
 	id := req.ID
 
@@ -982,7 +1042,7 @@ func (r *repositoryResource) ImportState(ctx context.Context, req resource.Impor
 	}
 
 	// Fetch the repository
-	repository, httpResp, err := r.client.GetRepo(username, repoName)
+	repository, httpResp, err := client.GetRepo(username, repoName)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.Diagnostics.AddError(
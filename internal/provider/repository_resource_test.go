@@ -522,3 +522,42 @@ resource "gitea_repository" "test" {
 }
 `, name, mergeStyle)
 }
+
+func TestAccRepositoryResource_DefaultOwner(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// The omitted username is resolved from default_owner and stored in state
+			{
+				Config: testAccRepositoryResourceConfigDefaultOwner("test-repo-default-owner"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository.test", "username", "root"),
+					resource.TestCheckResourceAttr("gitea_repository.test", "name", "test-repo-default-owner"),
+				),
+			},
+			// Spelling out the same owner is not a change
+			{
+				Config:   testAccRepositoryResourceConfig("test-repo-default-owner", "", false),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testAccRepositoryResourceConfigDefaultOwner(name string) string {
+	return `
+provider "gitea" {
+  gitea_username = "root"
+  gitea_password = "admin1234"
+  gitea_hostname = "http://localhost:3000"
+  default_owner  = "root"
+}
+` + fmt.Sprintf(`
+resource "gitea_repository" "test" {
+  name        = %[1]q
+  description = ""
+  private     = false
+}
+`, name)
+}
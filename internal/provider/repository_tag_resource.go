@@ -0,0 +1,373 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &repositoryTagResource{}
+	_ resource.ResourceWithConfigure   = &repositoryTagResource{}
+	_ resource.ResourceWithImportState = &repositoryTagResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryTagResource{}
+)
+
+func NewRepositoryTagResource() resource.Resource {
+	return &repositoryTagResource{}
+}
+
+type repositoryTagResource struct {
+	client       *gitea.Client
+	providerData *giteaProviderData
+}
+
+type repositoryTagResourceModel struct {
+	// Required
+	Repository types.String `tfsdk:"repository"`
+	Name       types.String `tfsdk:"name"`
+	Target     types.String `tfsdk:"target"`
+
+	// Optional
+	Owner   types.String `tfsdk:"owner"`
+	Message types.String `tfsdk:"message"`
+
+	// Computed
+	Id         types.String `tfsdk:"id"`
+	CommitSha  types.String `tfsdk:"commit_sha"`
+	TagSha     types.String `tfsdk:"tag_sha"`
+	TarballUrl types.String `tfsdk:"tarball_url"`
+	ZipballUrl types.String `tfsdk:"zipball_url"`
+}
+
+func (r *repositoryTagResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_tag"
+}
+
+func (r *repositoryTagResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages a git tag of a Gitea repository.",
+		MarkdownDescription: "Manages a git tag of a Gitea repository. A tag with a `message` is created as an annotated tag, otherwise as a lightweight tag. Tags cannot be changed, so any change replaces the tag. When the tag is moved to another commit outside of Terraform, `target` is set to that commit and the next apply puts the tag back.",
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the repository.",
+				MarkdownDescription: "Name of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the tag.",
+				MarkdownDescription: "Name of the tag.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target": schema.StringAttribute{
+				Required:            true,
+				Description:         "Branch name or commit SHA to tag. A branch is resolved to its head commit when the tag is created.",
+				MarkdownDescription: "Branch name or commit SHA to tag. A branch is resolved to its head commit when the tag is created.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Optional
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Owner of the repository. Defaults to the provider's default_owner.",
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"message": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Default:             stringdefault.StaticString(""),
+				Description:         "Message of an annotated tag. Leave empty to create a lightweight tag.",
+				MarkdownDescription: "Message of an annotated tag. Leave empty to create a lightweight tag.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of the tag in the format owner/repository/name.",
+				MarkdownDescription: "The ID of the tag in the format `owner/repository/name`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"commit_sha": schema.StringAttribute{
+				Computed:            true,
+				Description:         "SHA of the tagged commit.",
+				MarkdownDescription: "SHA of the tagged commit.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tag_sha": schema.StringAttribute{
+				Computed:            true,
+				Description:         "SHA of the tag object for an annotated tag, the commit SHA for a lightweight tag.",
+				MarkdownDescription: "SHA of the tag object for an annotated tag, the commit SHA for a lightweight tag.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"tarball_url": schema.StringAttribute{
+				Computed:            true,
+				Description:         "URL of the source code archive of the tag in tar.gz format.",
+				MarkdownDescription: "URL of the source code archive of the tag in tar.gz format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"zipball_url": schema.StringAttribute{
+				Computed:            true,
+				Description:         "URL of the source code archive of the tag in zip format.",
+				MarkdownDescription: "URL of the source code archive of the tag in zip format.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *repositoryTagResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*giteaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner.
+func (r *repositoryTagResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+}
+
+func (r *repositoryTagResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryTagResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+	name := plan.Name.ValueString()
+
+	tag, _, err := client.CreateTag(owner, repo, gitea.CreateTagOption{
+		TagName: name,
+		Message: plan.Message.ValueString(),
+		Target:  plan.Target.ValueString(),
+	})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tag",
+			fmt.Sprintf("Could not create tag '%s' in repository '%s/%s': %s", name, owner, repo, err.Error()),
+		)
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s/%s", owner, repo, name))
+	setTagComputed(&plan, tag)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryTagResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryTagResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+	name := state.Name.ValueString()
+
+	tag, httpResp, err := client.GetTag(owner, repo, name)
+	if err != nil {
+		// Handle 404 - tag was deleted outside of Terraform
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tag",
+			fmt.Sprintf("Could not read tag '%s' of repository '%s/%s': %s", name, owner, repo, err.Error()),
+		)
+		return
+	}
+
+	// A tag that now points to another commit was moved outside of Terraform.
+	// Reporting that commit as the target makes the next plan replace the tag.
+	if tag.Commit != nil && !state.CommitSha.IsNull() && tag.Commit.SHA != state.CommitSha.ValueString() {
+		state.Target = types.StringValue(tag.Commit.SHA)
+	}
+
+	// Gitea reports the commit message for a lightweight tag, so the message
+	// is only read back from annotated tags
+	message := ""
+	if tagIsAnnotated(tag) {
+		message = strings.TrimSpace(tag.Message)
+	}
+	if state.Message.IsNull() || message != strings.TrimSpace(state.Message.ValueString()) {
+		state.Message = types.StringValue(message)
+	}
+
+	setTagComputed(&state, tag)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *repositoryTagResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Tags cannot be updated - RequiresReplace plan modifiers ensure this never gets called
+	resp.Diagnostics.AddError(
+		"Update Not Supported",
+		"Tags cannot be updated. Terraform will recreate the resource.",
+	)
+}
+
+func (r *repositoryTagResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryTagResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+	name := state.Name.ValueString()
+
+	httpResp, err := client.DeleteTag(owner, repo, name)
+	if err != nil {
+		// If already deleted (404), consider it a success
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		if httpResp != nil && httpResp.StatusCode == 409 {
+			resp.Diagnostics.AddError(
+				"Error Deleting Tag",
+				fmt.Sprintf("Tag '%s' of repository '%s/%s' is used by a release. Delete the release first.", name, owner, repo),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Tag",
+			fmt.Sprintf("Could not delete tag '%s' of repository '%s/%s': %s", name, owner, repo, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *repositoryTagResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
+	// Import format: "owner/repository/tag", where the tag may contain slashes
+	parts := strings.SplitN(req.ID, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in format 'owner/repository/tag', got: %s", req.ID),
+		)
+		return
+	}
+
+	owner, repo, name := parts[0], parts[1], parts[2]
+
+	tag, httpResp, err := client.GetTag(owner, repo, name)
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.Diagnostics.AddError(
+				"Tag Not Found",
+				fmt.Sprintf("Repository '%s/%s' has no tag '%s'.", owner, repo, name),
+			)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Importing Tag",
+			fmt.Sprintf("Could not read tag '%s' of repository '%s/%s': %s", name, owner, repo, err.Error()),
+		)
+		return
+	}
+
+	// The branch a tag was created from is unknown, so the target is the commit
+	var target string
+	if tag.Commit != nil {
+		target = tag.Commit.SHA
+	}
+
+	// Read fills in the remaining attributes
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), owner)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), repo)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("name"), name)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("target"), target)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("commit_sha"), target)...)
+}
+
+// tagIsAnnotated reports whether tag has a tag object of its own.
+func tagIsAnnotated(tag *gitea.Tag) bool {
+	return tag.Commit != nil && tag.ID != "" && tag.ID != tag.Commit.SHA
+}
+
+// setTagComputed copies the attributes Gitea computes from tag.
+func setTagComputed(model *repositoryTagResourceModel, tag *gitea.Tag) {
+	model.CommitSha = types.StringValue("")
+	if tag.Commit != nil {
+		model.CommitSha = types.StringValue(tag.Commit.SHA)
+	}
+	model.TagSha = types.StringValue(tag.ID)
+	model.TarballUrl = types.StringValue(tag.TarballURL)
+	model.ZipballUrl = types.StringValue(tag.ZipballURL)
+}
@@ -0,0 +1,78 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestTagIsAnnotated(t *testing.T) {
+	commit := &gitea.CommitMeta{SHA: "a1b2c3"}
+
+	if tagIsAnnotated(&gitea.Tag{ID: "a1b2c3", Commit: commit}) {
+		t.Error("expected a tag pointing at the commit to be lightweight")
+	}
+	if !tagIsAnnotated(&gitea.Tag{ID: "d4e5f6", Commit: commit}) {
+		t.Error("expected a tag with its own object to be annotated")
+	}
+	if tagIsAnnotated(&gitea.Tag{ID: "d4e5f6"}) {
+		t.Error("expected a tag without a commit not to be annotated")
+	}
+}
+
+func TestAccRepositoryTagResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRepositoryTagResourceConfig(""),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_tag.test", "id", "root/test-repo-tags/v1.0.0"),
+					resource.TestCheckResourceAttr("gitea_repository_tag.test", "message", ""),
+					resource.TestCheckResourceAttrPair("gitea_repository_tag.test", "commit_sha", "gitea_repository_tag.test", "tag_sha"),
+					resource.TestCheckResourceAttrSet("gitea_repository_tag.test", "tarball_url"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "gitea_repository_tag.test",
+				ImportState:             true,
+				ImportStateId:           "root/test-repo-tags/v1.0.0",
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"target"},
+			},
+			// Replace with an annotated tag
+			{
+				Config: testAccRepositoryTagResourceConfig("First stable release"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_tag.test", "message", "First stable release"),
+					resource.TestCheckResourceAttrSet("gitea_repository_tag.test", "tag_sha"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryTagResourceConfig(message string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "gitea_repository" "test" {
+  username       = "root"
+  name           = "test-repo-tags"
+  private        = false
+  auto_init      = true
+  default_branch = "main"
+}
+
+resource "gitea_repository_tag" "test" {
+  owner      = gitea_repository.test.username
+  repository = gitea_repository.test.name
+  name       = "v1.0.0"
+  target     = "main"
+  message    = %q
+}
+`, message)
+}
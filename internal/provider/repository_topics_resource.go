@@ -0,0 +1,286 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &repositoryTopicsResource{}
+	_ resource.ResourceWithConfigure   = &repositoryTopicsResource{}
+	_ resource.ResourceWithImportState = &repositoryTopicsResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryTopicsResource{}
+)
+
+// repositoryTopicRegexp matches the topics Gitea accepts. Gitea stores topics
+// in lower case, so upper case letters are rejected to avoid a perpetual diff.
+var repositoryTopicRegexp = regexp.MustCompile(`^[a-z0-9][a-z0-9.-]{0,34}$`)
+
+// maxRepositoryTopics is the number of topics Gitea allows per repository.
+const maxRepositoryTopics = 25
+
+func NewRepositoryTopicsResource() resource.Resource {
+	return &repositoryTopicsResource{}
+}
+
+type repositoryTopicsResource struct {
+	client       *gitea.Client
+	providerData *giteaProviderData
+}
+
+type repositoryTopicsResourceModel struct {
+	// Required
+	Repository types.String `tfsdk:"repository"`
+	Topics     types.Set    `tfsdk:"topics"`
+
+	// Optional
+	Owner types.String `tfsdk:"owner"`
+
+	// Computed
+	Id types.String `tfsdk:"id"`
+}
+
+func (r *repositoryTopicsResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_topics"
+}
+
+func (r *repositoryTopicsResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description:         "Manages the topics of a Gitea repository.",
+		MarkdownDescription: "Manages the topics of a Gitea repository. This resource is authoritative: topics added outside of Terraform are reported as drift and removed on the next apply. Destroying the resource removes all topics from the repository.",
+		Attributes: map[string]schema.Attribute{
+			// Required
+			"repository": schema.StringAttribute{
+				Required:            true,
+				Description:         "Name of the repository.",
+				MarkdownDescription: "Name of the repository.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"topics": schema.SetAttribute{
+				Required:            true,
+				ElementType:         types.StringType,
+				Description:         "Topics of the repository. A topic consists of lower case letters, digits, dashes and dots, starts with a letter or digit and is at most 35 characters long. Up to 25 topics are allowed.",
+				MarkdownDescription: "Topics of the repository. A topic consists of lower case letters, digits, dashes and dots, starts with a letter or digit and is at most 35 characters long. Up to 25 topics are allowed.",
+				Validators: []validator.Set{
+					setvalidator.SizeAtMost(maxRepositoryTopics),
+					setvalidator.ValueStringsAre(
+						stringvalidator.RegexMatches(repositoryTopicRegexp, "must consist of lower case letters, digits, dashes and dots, start with a letter or digit and be at most 35 characters long"),
+					),
+				},
+			},
+
+			// Optional
+			"owner": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "Owner of the repository. Defaults to the provider's default_owner.",
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+
+			// Computed
+			"id": schema.StringAttribute{
+				Computed:            true,
+				Description:         "The ID of this resource, in the format owner/repository.",
+				MarkdownDescription: "The ID of this resource, in the format `owner/repository`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *repositoryTopicsResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*giteaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner.
+func (r *repositoryTopicsResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
+}
+
+func (r *repositoryTopicsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryTopicsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setTopics(ctx, client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", plan.Owner.ValueString(), plan.Repository.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryTopicsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryTopicsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+
+	// A repository has at most 25 topics, so one page holds all of them
+	topics, httpResp, err := client.ListRepoTopics(owner, repo, gitea.ListRepoTopicsOptions{
+		ListOptions: gitea.ListOptions{PageSize: 50},
+	})
+	if err != nil {
+		// Handle 404 - the repository was deleted outside of Terraform
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Topics",
+			fmt.Sprintf("Could not list topics of repository '%s/%s': %s", owner, repo, err.Error()),
+		)
+		return
+	}
+
+	if topics == nil {
+		topics = []string{}
+	}
+
+	var diags diag.Diagnostics
+	state.Topics, diags = types.SetValueFrom(ctx, types.StringType, topics)
+	resp.Diagnostics.Append(diags...)
+	state.Id = types.StringValue(fmt.Sprintf("%s/%s", owner, repo))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *repositoryTopicsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryTopicsResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(r.setTopics(ctx, client, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(fmt.Sprintf("%s/%s", plan.Owner.ValueString(), plan.Repository.ValueString()))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *repositoryTopicsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryTopicsResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	owner := state.Owner.ValueString()
+	repo := state.Repository.ValueString()
+
+	httpResp, err := client.SetRepoTopics(owner, repo, []string{})
+	if err != nil {
+		// If the repository is already gone (404), consider it a success
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Removing Topics",
+			fmt.Sprintf("Could not remove the topics of repository '%s/%s': %s", owner, repo, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *repositoryTopicsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	// Import format: "owner/repository"
+	parts := strings.Split(req.ID, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in format 'owner/repository', got: %s", req.ID),
+		)
+		return
+	}
+
+	// Read fills in the topics
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("owner"), parts[0])...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("repository"), parts[1])...)
+}
+
+// setTopics replaces the topics of the repository with the planned ones.
+func (r *repositoryTopicsResource) setTopics(ctx context.Context, client *gitea.Client, plan *repositoryTopicsResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	owner := plan.Owner.ValueString()
+	repo := plan.Repository.ValueString()
+
+	topics := []string{}
+	diags.Append(plan.Topics.ElementsAs(ctx, &topics, false)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if _, err := client.SetRepoTopics(owner, repo, topics); err != nil {
+		diags.AddError(
+			"Error Setting Topics",
+			fmt.Sprintf("Could not set the topics of repository '%s/%s': %s", owner, repo, err.Error()),
+		)
+	}
+
+	return diags
+}
@@ -0,0 +1,77 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestRepositoryTopicRegexp(t *testing.T) {
+	for _, topic := range []string{"go", "terraform-provider", "v1.2", "0day", "abcdefghijklmnopqrstuvwxyz012345678"} {
+		if !repositoryTopicRegexp.MatchString(topic) {
+			t.Errorf("expected topic %q to be accepted", topic)
+		}
+	}
+	for _, topic := range []string{"", "Go", "-go", ".go", "go lang", "go_lang", "abcdefghijklmnopqrstuvwxyz0123456789"} {
+		if repositoryTopicRegexp.MatchString(topic) {
+			t.Errorf("expected topic %q to be rejected", topic)
+		}
+	}
+}
+
+func TestAccRepositoryTopicsResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRepositoryTopicsResourceConfig(`["terraform", "gitea"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_topics.test", "id", "root/test-repo-topics"),
+					resource.TestCheckResourceAttr("gitea_repository_topics.test", "topics.#", "2"),
+					resource.TestCheckTypeSetElemAttr("gitea_repository_topics.test", "topics.*", "terraform"),
+					resource.TestCheckTypeSetElemAttr("gitea_repository_topics.test", "topics.*", "gitea"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "gitea_repository_topics.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRepositoryTopicsResourceConfig(`["service-catalog"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_topics.test", "topics.#", "1"),
+					resource.TestCheckTypeSetElemAttr("gitea_repository_topics.test", "topics.*", "service-catalog"),
+				),
+			},
+			// Removing all topics
+			{
+				Config: testAccRepositoryTopicsResourceConfig(`[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_topics.test", "topics.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryTopicsResourceConfig(topics string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "gitea_repository" "test" {
+  username = "root"
+  name     = "test-repo-topics"
+  private  = false
+}
+
+resource "gitea_repository_topics" "test" {
+  owner      = gitea_repository.test.username
+  repository = gitea_repository.test.name
+  topics     = %s
+}
+`, topics)
+}
@@ -20,13 +20,15 @@ import (
 var _ resource.Resource = (*repositoryWebhookResource)(nil)
 var _ resource.ResourceWithConfigure = (*repositoryWebhookResource)(nil)
 var _ resource.ResourceWithImportState = (*repositoryWebhookResource)(nil)
+var _ resource.ResourceWithModifyPlan = (*repositoryWebhookResource)(nil)
 
 func NewRepositoryWebhookResource() resource.Resource {
 	return &repositoryWebhookResource{}
 }
 
 type repositoryWebhookResource struct {
-	client *gitea.Client
+	client       *gitea.Client
+	providerData *giteaProviderData
 }
 
 type repositoryWebhookResourceModel struct {
@@ -57,10 +59,11 @@ func (r *repositoryWebhookResource) Schema(ctx context.Context, req resource.Sch
 		MarkdownDescription: "Manages a repository webhook",
 		Attributes: map[string]schema.Attribute{
 			"owner": schema.StringAttribute{
-				Required:            true,
-				MarkdownDescription: "Owner of the repository",
+				Optional:            true,
+				Computed:            true,
+				MarkdownDescription: "Owner of the repository. Defaults to the provider's `default_owner`.",
 				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.RequiresReplaceIfConfigured(),
 				},
 			},
 			"repository": schema.StringAttribute{
@@ -126,19 +129,31 @@ func (r *repositoryWebhookResource) Configure(ctx context.Context, req resource.
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted owner from the provider's default_owner.
+func (r *repositoryWebhookResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("owner"), req, resp)
 }
 
 func (r *repositoryWebhookResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryWebhookResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -167,7 +182,7 @@ func (r *repositoryWebhookResource) Create(ctx context.Context, req resource.Cre
 		AuthorizationHeader: data.AuthorizationHeader.ValueString(),
 	}
 
-	hook, _, err := r.client.CreateRepoHook(data.Owner.ValueString(), data.Repo.ValueString(), opt)
+	hook, _, err := client.CreateRepoHook(data.Owner.ValueString(), data.Repo.ValueString(), opt)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create repository webhook, got error: %s", err))
 		return
@@ -185,6 +200,8 @@ func (r *repositoryWebhookResource) Create(ctx context.Context, req resource.Cre
 }
 
 func (r *repositoryWebhookResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryWebhookResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -192,7 +209,7 @@ func (r *repositoryWebhookResource) Read(ctx context.Context, req resource.ReadR
 		return
 	}
 
-	hook, httpResp, err := r.client.GetRepoHook(data.Owner.ValueString(), data.Repo.ValueString(), data.Id.ValueInt64())
+	hook, httpResp, err := client.GetRepoHook(data.Owner.ValueString(), data.Repo.ValueString(), data.Id.ValueInt64())
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.State.RemoveResource(ctx)
@@ -234,6 +251,8 @@ func (r *repositoryWebhookResource) Read(ctx context.Context, req resource.ReadR
 }
 
 func (r *repositoryWebhookResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryWebhookResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
@@ -262,14 +281,14 @@ func (r *repositoryWebhookResource) Update(ctx context.Context, req resource.Upd
 		AuthorizationHeader: data.AuthorizationHeader.ValueString(),
 	}
 
-	_, err := r.client.EditRepoHook(data.Owner.ValueString(), data.Repo.ValueString(), data.Id.ValueInt64(), opt)
+	_, err := client.EditRepoHook(data.Owner.ValueString(), data.Repo.ValueString(), data.Id.ValueInt64(), opt)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update repository webhook, got error: %s", err))
 		return
 	}
 
 	// Read back the updated webhook
-	hook, _, err := r.client.GetRepoHook(data.Owner.ValueString(), data.Repo.ValueString(), data.Id.ValueInt64())
+	hook, _, err := client.GetRepoHook(data.Owner.ValueString(), data.Repo.ValueString(), data.Id.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read updated repository webhook, got error: %s", err))
 		return
@@ -283,6 +302,8 @@ func (r *repositoryWebhookResource) Update(ctx context.Context, req resource.Upd
 }
 
 func (r *repositoryWebhookResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var data repositoryWebhookResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
@@ -290,7 +311,7 @@ func (r *repositoryWebhookResource) Delete(ctx context.Context, req resource.Del
 		return
 	}
 
-	_, err := r.client.DeleteRepoHook(data.Owner.ValueString(), data.Repo.ValueString(), data.Id.ValueInt64())
+	_, err := client.DeleteRepoHook(data.Owner.ValueString(), data.Repo.ValueString(), data.Id.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to delete repository webhook, got error: %s", err))
 		return
@@ -0,0 +1,382 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"code.gitea.io/sdk/gitea"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var (
+	_ resource.Resource                = &repositoryTagProtectionResource{}
+	_ resource.ResourceWithConfigure   = &repositoryTagProtectionResource{}
+	_ resource.ResourceWithImportState = &repositoryTagProtectionResource{}
+	_ resource.ResourceWithModifyPlan  = &repositoryTagProtectionResource{}
+)
+
+func NewRepositoryTagProtectionResource() resource.Resource {
+	return &repositoryTagProtectionResource{}
+}
+
+type repositoryTagProtectionResource struct {
+	client       *gitea.Client
+	providerData *giteaProviderData
+}
+
+// repositoryTagProtectionResourceModel describes the resource data model.
+type repositoryTagProtectionResourceModel struct {
+	// Required - identification fields
+	Username    types.String `tfsdk:"username"`
+	Name        types.String `tfsdk:"name"`
+	NamePattern types.String `tfsdk:"name_pattern"`
+
+	// Optional - Push settings
+	WhitelistUsernames types.List `tfsdk:"whitelist_usernames"`
+	WhitelistTeams     types.List `tfsdk:"whitelist_teams"`
+
+	// Computed - read-only metadata
+	Id        types.Int64  `tfsdk:"id"`
+	CreatedAt types.String `tfsdk:"created_at"`
+	UpdatedAt types.String `tfsdk:"updated_at"`
+}
+
+func (r *repositoryTagProtectionResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_repository_tag_protection"
+}
+
+func (r *repositoryTagProtectionResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	emptyList := types.ListValueMust(types.StringType, []attr.Value{})
+
+	resp.Schema = schema.Schema{
+		Description:         "Manages tag protection rules for a Gitea repository.",
+		MarkdownDescription: "Manages tag protection rules for a Gitea repository. Only the allowlisted users and teams can create, move or delete tags matching the rule. Requires Gitea 1.23 or later.",
+		Attributes: map[string]schema.Attribute{
+			// Required identification fields
+			"username": schema.StringAttribute{
+				Optional:            true,
+				Computed:            true,
+				Description:         "User name or organization name. Defaults to the provider's default_owner.",
+				MarkdownDescription: "User name or organization name. Defaults to the provider's `default_owner`.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplaceIfConfigured(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Required:            true,
+				Description:         "Repository name.",
+				MarkdownDescription: "Repository name.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name_pattern": schema.StringAttribute{
+				Required:            true,
+				Description:         "Protected tag name pattern. Either a glob pattern such as v* or a regular expression enclosed in slashes such as /^v[0-9]+/.",
+				MarkdownDescription: "Protected tag name pattern. Either a glob pattern such as `v*` or a regular expression enclosed in slashes such as `/^v[0-9]+/`.",
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+
+			// Push settings
+			"whitelist_usernames": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(emptyList),
+				Description:         "Allowlisted users for pushing matching tags.",
+				MarkdownDescription: "Allowlisted users for pushing matching tags.",
+			},
+			"whitelist_teams": schema.ListAttribute{
+				ElementType:         types.StringType,
+				Optional:            true,
+				Computed:            true,
+				Default:             listdefault.StaticValue(emptyList),
+				Description:         "Allowlisted teams for pushing matching tags. Only available for organization repositories.",
+				MarkdownDescription: "Allowlisted teams for pushing matching tags. Only available for organization repositories.",
+			},
+
+			// Computed fields
+			"id": schema.Int64Attribute{
+				Computed:            true,
+				Description:         "The ID of the tag protection rule.",
+				MarkdownDescription: "The ID of the tag protection rule.",
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Timestamp when the rule was created.",
+				MarkdownDescription: "Timestamp when the rule was created.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				Computed:            true,
+				Description:         "Timestamp when the rule was last updated.",
+				MarkdownDescription: "Timestamp when the rule was last updated.",
+			},
+		},
+	}
+}
+
+func (r *repositoryTagProtectionResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerData, ok := req.ProviderData.(*giteaProviderData)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fills an omitted username from the provider's default_owner and
+// fails planning early on servers that predate tag protection.
+func (r *repositoryTagProtectionResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	r.providerData.applyDefaultOwner(ctx, path.Root("username"), req, resp)
+
+	resp.Diagnostics.Append(r.providerData.checkServerVersion("The gitea_repository_tag_protection resource", "1.23.0")...)
+}
+
+func (r *repositoryTagProtectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryTagProtectionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createOpts := gitea.CreateTagProtectionOption{
+		NamePattern:        plan.NamePattern.ValueString(),
+		WhitelistUsernames: []string{},
+		WhitelistTeams:     []string{},
+	}
+	resp.Diagnostics.Append(plan.WhitelistUsernames.ElementsAs(ctx, &createOpts.WhitelistUsernames, false)...)
+	resp.Diagnostics.Append(plan.WhitelistTeams.ElementsAs(ctx, &createOpts.WhitelistTeams, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	protection, _, err := client.CreateTagProtection(
+		plan.Username.ValueString(),
+		plan.Name.ValueString(),
+		createOpts,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Creating Tag Protection",
+			fmt.Sprintf("Could not create tag protection rule '%s' for %s/%s: %s",
+				plan.NamePattern.ValueString(), plan.Username.ValueString(), plan.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	mapTagProtectionToModel(protection, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *repositoryTagProtectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryTagProtectionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.Username.ValueString()
+	name := state.Name.ValueString()
+
+	protection, httpResp, err := client.GetTagProtection(username, name, state.Id.ValueInt64())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Reading Tag Protection",
+			fmt.Sprintf("Could not read tag protection rule %d for %s/%s: %s",
+				state.Id.ValueInt64(), username, name, err.Error()),
+		)
+		return
+	}
+
+	mapTagProtectionToModel(protection, &state)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *repositoryTagProtectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
+	var plan repositoryTagProtectionResourceModel
+	var state repositoryTagProtectionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Empty lists are sent as such, Gitea keeps the allowlists left out
+	editOpts := gitea.EditTagProtectionOption{
+		NamePattern:        plan.NamePattern.ValueStringPointer(),
+		WhitelistUsernames: []string{},
+		WhitelistTeams:     []string{},
+	}
+	resp.Diagnostics.Append(plan.WhitelistUsernames.ElementsAs(ctx, &editOpts.WhitelistUsernames, false)...)
+	resp.Diagnostics.Append(plan.WhitelistTeams.ElementsAs(ctx, &editOpts.WhitelistTeams, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	protection, _, err := client.EditTagProtection(
+		state.Username.ValueString(),
+		state.Name.ValueString(),
+		state.Id.ValueInt64(),
+		editOpts,
+	)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Updating Tag Protection",
+			fmt.Sprintf("Could not update tag protection rule '%s' for %s/%s: %s",
+				state.NamePattern.ValueString(), state.Username.ValueString(), state.Name.ValueString(), err.Error()),
+		)
+		return
+	}
+
+	mapTagProtectionToModel(protection, &plan)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *repositoryTagProtectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
+	var state repositoryTagProtectionResourceModel
+
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	username := state.Username.ValueString()
+	name := state.Name.ValueString()
+
+	httpResp, err := client.DeleteTagProtection(username, name, state.Id.ValueInt64())
+	if err != nil {
+		if httpResp != nil && httpResp.StatusCode == 404 {
+			return
+		}
+		resp.Diagnostics.AddError(
+			"Error Deleting Tag Protection",
+			fmt.Sprintf("Could not delete tag protection rule '%s' for %s/%s: %s",
+				state.NamePattern.ValueString(), username, name, err.Error()),
+		)
+		return
+	}
+}
+
+func (r *repositoryTagProtectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
+	// Import format: "username/name/name_pattern", where the pattern may contain slashes
+	id := req.ID
+
+	parts := strings.SplitN(id, "/", 3)
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		resp.Diagnostics.AddError(
+			"Invalid Import ID",
+			fmt.Sprintf("Import ID must be in format 'username/name/name_pattern', got: %s", id),
+		)
+		return
+	}
+
+	username := parts[0]
+	name := parts[1]
+	namePattern := parts[2]
+
+	// Gitea returns all tag protection rules of a repository in one response
+	protections, _, err := client.ListTagProtection(username, name, gitea.ListRepoTagProtectionsOptions{})
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Importing Tag Protection",
+			fmt.Sprintf("Could not list tag protection rules for %s/%s: %s", username, name, err.Error()),
+		)
+		return
+	}
+
+	var protection *gitea.TagProtection
+	for _, p := range protections {
+		if p.NamePattern == namePattern {
+			protection = p
+			break
+		}
+	}
+	if protection == nil {
+		resp.Diagnostics.AddError(
+			"Tag Protection Not Found",
+			fmt.Sprintf("Tag protection rule '%s' not found for %s/%s", namePattern, username, name),
+		)
+		return
+	}
+
+	var data repositoryTagProtectionResourceModel
+	data.Username = types.StringValue(username)
+	data.Name = types.StringValue(name)
+	mapTagProtectionToModel(protection, &data)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// mapTagProtectionToModel copies a Gitea TagProtection into the Terraform model.
+func mapTagProtectionToModel(protection *gitea.TagProtection, model *repositoryTagProtectionResourceModel) {
+	model.Id = types.Int64Value(protection.Id)
+	model.NamePattern = types.StringValue(protection.NamePattern)
+
+	// Map timestamp fields
+	if !protection.Created.IsZero() {
+		model.CreatedAt = types.StringValue(protection.Created.Format("2006-01-02T15:04:05Z07:00"))
+	} else {
+		model.CreatedAt = types.StringNull()
+	}
+	if !protection.Updated.IsZero() {
+		model.UpdatedAt = types.StringValue(protection.Updated.Format("2006-01-02T15:04:05Z07:00"))
+	} else {
+		model.UpdatedAt = types.StringNull()
+	}
+
+	// Map list fields - always set them even if empty to ensure consistent state
+	model.WhitelistUsernames = stringSliceToListBP(protection.WhitelistUsernames)
+	model.WhitelistTeams = stringSliceToListBP(protection.WhitelistTeams)
+}
@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccRepositoryTagProtectionResource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: testAccRepositoryTagProtectionResourceConfig("v*", `["root"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_tag_protection.test", "name_pattern", "v*"),
+					resource.TestCheckResourceAttr("gitea_repository_tag_protection.test", "whitelist_usernames.#", "1"),
+					resource.TestCheckResourceAttr("gitea_repository_tag_protection.test", "whitelist_usernames.0", "root"),
+					resource.TestCheckResourceAttr("gitea_repository_tag_protection.test", "whitelist_teams.#", "0"),
+					resource.TestCheckResourceAttrSet("gitea_repository_tag_protection.test", "id"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "gitea_repository_tag_protection.test",
+				ImportState:       true,
+				ImportStateId:     "root/test-repo-tag-protection/v*",
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: testAccRepositoryTagProtectionResourceConfig("/^v[0-9]+/", `[]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("gitea_repository_tag_protection.test", "name_pattern", "/^v[0-9]+/"),
+					resource.TestCheckResourceAttr("gitea_repository_tag_protection.test", "whitelist_usernames.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccRepositoryTagProtectionResourceConfig(namePattern, usernames string) string {
+	return providerConfig() + fmt.Sprintf(`
+resource "gitea_repository" "test" {
+  username = "root"
+  name     = "test-repo-tag-protection"
+  private  = true
+}
+
+resource "gitea_repository_tag_protection" "test" {
+  username            = "root"
+  name                = gitea_repository.test.name
+  name_pattern        = %[1]q
+  whitelist_usernames = %[2]s
+}
+`, namePattern, usernames)
+}
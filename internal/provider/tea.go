@@ -0,0 +1,60 @@
+package provider
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// teaConfig is the part of the tea CLI configuration file the provider reads.
+type teaConfig struct {
+	Logins []teaLogin `yaml:"logins"`
+}
+
+// teaLogin is a named login created with `tea login add`.
+type teaLogin struct {
+	Name     string `yaml:"name"`
+	URL      string `yaml:"url"`
+	Token    string `yaml:"token"`
+	Insecure bool   `yaml:"insecure"`
+}
+
+// teaConfigPath returns the location tea stores its configuration at,
+// $XDG_CONFIG_HOME/tea/config.yml or the platform's equivalent.
+func teaConfigPath() (string, error) {
+	dir, err := os.UserConfigDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "tea", "config.yml"), nil
+}
+
+// loadTeaLogin reads the login called name from the tea configuration file
+// at configPath. Login names are matched case-insensitively, as tea does.
+func loadTeaLogin(configPath, name string) (*teaLogin, error) {
+	content, err := os.ReadFile(configPath)
+	if err != nil {
+		return nil, fmt.Errorf("could not read tea configuration: %w", err)
+	}
+
+	var config teaConfig
+	if err := yaml.Unmarshal(content, &config); err != nil {
+		return nil, fmt.Errorf("could not parse tea configuration '%s': %w", configPath, err)
+	}
+
+	names := make([]string, 0, len(config.Logins))
+	for i := range config.Logins {
+		if strings.EqualFold(config.Logins[i].Name, name) {
+			return &config.Logins[i], nil
+		}
+		names = append(names, config.Logins[i].Name)
+	}
+
+	if len(names) == 0 {
+		return nil, fmt.Errorf("login '%s' not found, '%s' has no logins", name, configPath)
+	}
+	return nil, fmt.Errorf("login '%s' not found in '%s', available logins: %s", name, configPath, strings.Join(names, ", "))
+}
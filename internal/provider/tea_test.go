@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/provider"
+)
+
+const testTeaConfig = `logins:
+- name: gitea.com
+  url: https://gitea.com
+  token: public-token
+  default: true
+  user: alice
+- name: Work
+  url: https://git.example.com
+  token: work-token
+  insecure: true
+  ssh_host: git.example.com
+  user: alice
+preferences:
+  editor: false
+`
+
+func writeTeaConfig(t *testing.T, content string) string {
+	t.Helper()
+	configPath := filepath.Join(t.TempDir(), "config.yml")
+	if err := os.WriteFile(configPath, []byte(content), 0o600); err != nil {
+		t.Fatalf("could not write tea configuration: %s", err)
+	}
+	return configPath
+}
+
+func TestLoadTeaLogin(t *testing.T) {
+	configPath := writeTeaConfig(t, testTeaConfig)
+
+	login, err := loadTeaLogin(configPath, "work")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if login.URL != "https://git.example.com" || login.Token != "work-token" || !login.Insecure {
+		t.Fatalf("unexpected login: %+v", login)
+	}
+
+	login, err = loadTeaLogin(configPath, "gitea.com")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if login.Insecure {
+		t.Error("expected insecure to default to false")
+	}
+}
+
+func TestLoadTeaLogin_NotFound(t *testing.T) {
+	configPath := writeTeaConfig(t, testTeaConfig)
+
+	_, err := loadTeaLogin(configPath, "home")
+	if err == nil || !strings.Contains(err.Error(), "gitea.com, Work") {
+		t.Fatalf("expected the error to list the available logins, got: %v", err)
+	}
+
+	if _, err := loadTeaLogin(filepath.Join(t.TempDir(), "missing.yml"), "work"); err == nil {
+		t.Error("expected an error for a missing configuration file")
+	}
+}
+
+// testConfigureWithTeaLogin configures the provider with tea_login and, when
+// not empty, a username and password, reading the tea configuration from a
+// fresh config directory. The login points at a fake Gitea server, whose
+// received Authorization headers are returned.
+func testConfigureWithTeaLogin(t *testing.T, username, password string) (*provider.ConfigureResponse, []string) {
+	t.Helper()
+
+	server, requests := testGiteaServer(t)
+
+	configDir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(configDir, "tea"), 0o700); err != nil {
+		t.Fatalf("could not create tea configuration directory: %s", err)
+	}
+	content := "logins:\n- name: work\n  url: " + server.URL + "\n  token: work-token\n"
+	if err := os.WriteFile(filepath.Join(configDir, "tea", "config.yml"), []byte(content), 0o600); err != nil {
+		t.Fatalf("could not write tea configuration: %s", err)
+	}
+	t.Setenv("XDG_CONFIG_HOME", configDir)
+	t.Setenv("HOME", configDir)
+
+	attributes := map[string]string{"tea_login": "work"}
+	if username != "" {
+		attributes["gitea_username"] = username
+		attributes["gitea_password"] = password
+	}
+	resp := testConfigureProvider(t, attributes)
+
+	var authorizations []string
+	for _, request := range *requests {
+		authorizations = append(authorizations, request.Header.Get("Authorization"))
+	}
+	return resp, authorizations
+}
+
+func TestConfigure_TeaLogin(t *testing.T) {
+	resp, authorizations := testConfigureWithTeaLogin(t, "", "")
+	if resp.Diagnostics.HasError() || resp.Diagnostics.WarningsCount() != 0 {
+		t.Fatalf("unexpected diagnostics: %v", resp.Diagnostics)
+	}
+	if resp.ResourceData == nil {
+		t.Fatal("expected the provider to be configured")
+	}
+	if len(authorizations) == 0 {
+		t.Fatal("expected requests to the hostname of the tea login")
+	}
+	for _, authorization := range authorizations {
+		if authorization != "token work-token" {
+			t.Errorf("expected the token of the tea login, got Authorization %q", authorization)
+		}
+	}
+}
+
+func TestConfigure_TeaLoginTokenIgnoredForUsername(t *testing.T) {
+	resp, authorizations := testConfigureWithTeaLogin(t, "alice", "secret")
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected errors: %v", resp.Diagnostics)
+	}
+	if resp.Diagnostics.WarningsCount() != 1 || resp.Diagnostics.Warnings()[0].Summary() != "Ignoring tea Login Token" {
+		t.Fatalf("expected a warning about the ignored tea token, got: %v", resp.Diagnostics)
+	}
+	for _, authorization := range authorizations {
+		if !strings.HasPrefix(authorization, "Basic ") {
+			t.Errorf("expected basic authentication, got Authorization %q", authorization)
+		}
+	}
+}
@@ -73,19 +73,21 @@ func (d *teamMembershipDataSource) Configure(_ context.Context, req datasource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
 }
 
 func (d *teamMembershipDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	client := d.client.WithContext(ctx)
+
 	var data teamMembershipDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -98,7 +100,7 @@ func (d *teamMembershipDataSource) Read(ctx context.Context, req datasource.Read
 	username := data.Username.ValueString()
 
 	// Get team ID from name
-	teams, httpResp, err := d.client.ListOrgTeams(org, gitea.ListTeamsOptions{})
+	teams, httpResp, err := client.ListOrgTeams(org, gitea.ListTeamsOptions{})
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
 			resp.Diagnostics.AddError(
@@ -131,7 +133,7 @@ func (d *teamMembershipDataSource) Read(ctx context.Context, req datasource.Read
 	}
 
 	// Check if the user is a member of the team
-	_, httpResp, err = d.client.GetTeamMember(teamID, username)
+	_, httpResp, err = client.GetTeamMember(teamID, username)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
 			resp.Diagnostics.AddError(
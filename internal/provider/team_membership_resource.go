@@ -83,19 +83,21 @@ func (r *teamMembershipResource) Configure(_ context.Context, req resource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 func (r *teamMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan teamMembershipResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -106,7 +108,7 @@ func (r *teamMembershipResource) Create(ctx context.Context, req resource.Create
 	teamID := plan.TeamId.ValueInt64()
 	username := plan.Username.ValueString()
 
-	_, err := r.client.AddTeamMember(teamID, username)
+	_, err := client.AddTeamMember(teamID, username)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Adding Team Member",
@@ -122,6 +124,8 @@ func (r *teamMembershipResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *teamMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state teamMembershipResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -133,7 +137,7 @@ func (r *teamMembershipResource) Read(ctx context.Context, req resource.ReadRequ
 	username := state.Username.ValueString()
 
 	// Check if the user is still a member of the team
-	_, httpResp, err := r.client.GetTeamMember(teamID, username)
+	_, httpResp, err := client.GetTeamMember(teamID, username)
 	if err != nil {
 		// Handle 404 - membership was deleted outside of Terraform
 		if httpResp != nil && httpResp.StatusCode == 404 {
@@ -163,6 +167,8 @@ func (r *teamMembershipResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *teamMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state teamMembershipResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -173,7 +179,7 @@ func (r *teamMembershipResource) Delete(ctx context.Context, req resource.Delete
 	teamID := state.TeamId.ValueInt64()
 	username := state.Username.ValueString()
 
-	httpResp, err := r.client.RemoveTeamMember(teamID, username)
+	httpResp, err := client.RemoveTeamMember(teamID, username)
 	if err != nil {
 		// If already deleted (404), consider it a success
 		if httpResp != nil && httpResp.StatusCode == 404 {
@@ -188,6 +194,8 @@ func (r *teamMembershipResource) Delete(ctx context.Context, req resource.Delete
 }
 
 func (r *teamMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
 	// Import format: "team_id/username"
 	id := req.ID
 
@@ -223,7 +231,7 @@ func (r *teamMembershipResource) ImportState(ctx context.Context, req resource.I
 	}
 
 	// Verify the membership exists
-	_, httpResp, err := r.client.GetTeamMember(teamID, username)
+	_, httpResp, err := client.GetTeamMember(teamID, username)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.Diagnostics.AddError(
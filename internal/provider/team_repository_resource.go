@@ -88,24 +88,24 @@ func (r *TeamRepositoryResource) Configure(ctx context.Context, req resource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 // findTeamByName searches for a team by name within an organization.
 // Returns the team ID if found, 0 if not found, and an error if the API call fails.
-func (r *TeamRepositoryResource) findTeamByName(org, teamName string) (int64, error) {
+func (r *TeamRepositoryResource) findTeamByName(client *gitea.Client, org, teamName string) (int64, error) {
 	page := 1
 	for {
-		teams, _, err := r.client.ListOrgTeams(org, gitea.ListTeamsOptions{
+		teams, _, err := client.ListOrgTeams(org, gitea.ListTeamsOptions{
 			ListOptions: gitea.ListOptions{
 				Page:     page,
 				PageSize: 50, // Use default max page size
@@ -135,10 +135,10 @@ func (r *TeamRepositoryResource) findTeamByName(org, teamName string) (int64, er
 
 // checkRepositoryInTeam checks if a repository is assigned to a team.
 // Returns true if found, false otherwise.
-func (r *TeamRepositoryResource) checkRepositoryInTeam(teamID int64, repoName string) (bool, error) {
+func (r *TeamRepositoryResource) checkRepositoryInTeam(client *gitea.Client, teamID int64, repoName string) (bool, error) {
 	page := 1
 	for {
-		repos, _, err := r.client.ListTeamRepositories(teamID, gitea.ListTeamRepositoriesOptions{
+		repos, _, err := client.ListTeamRepositories(teamID, gitea.ListTeamRepositoriesOptions{
 			ListOptions: gitea.ListOptions{
 				Page:     page,
 				PageSize: 50, // Use default max page size
@@ -179,6 +179,8 @@ func (r *TeamRepositoryResource) checkRepositoryInTeam(teamID int64, repoName st
 }
 
 func (r *TeamRepositoryResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan teamRepositoryResourceModel
 	diags := req.Plan.Get(ctx, &plan)
 	resp.Diagnostics.Append(diags...)
@@ -191,7 +193,7 @@ func (r *TeamRepositoryResource) Create(ctx context.Context, req resource.Create
 	repoName := plan.RepositoryName.ValueString()
 
 	// Get team ID by name
-	teamID, err := r.findTeamByName(org, teamName)
+	teamID, err := r.findTeamByName(client, org, teamName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Listing Teams",
@@ -209,7 +211,7 @@ func (r *TeamRepositoryResource) Create(ctx context.Context, req resource.Create
 	}
 
 	// Add repository to team
-	httpResp, err := r.client.AddTeamRepository(teamID, org, repoName)
+	httpResp, err := client.AddTeamRepository(teamID, org, repoName)
 	if err != nil {
 		// Handle 404 - repository or team not found
 		if httpResp != nil && httpResp.StatusCode == 404 {
@@ -234,6 +236,8 @@ func (r *TeamRepositoryResource) Create(ctx context.Context, req resource.Create
 }
 
 func (r *TeamRepositoryResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state teamRepositoryResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -246,7 +250,7 @@ func (r *TeamRepositoryResource) Read(ctx context.Context, req resource.ReadRequ
 	repoName := state.RepositoryName.ValueString()
 
 	// Get team ID by name
-	teamID, err := r.findTeamByName(org, teamName)
+	teamID, err := r.findTeamByName(client, org, teamName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Listing Teams",
@@ -262,7 +266,7 @@ func (r *TeamRepositoryResource) Read(ctx context.Context, req resource.ReadRequ
 	}
 
 	// Check if repository is still assigned to team
-	found, err := r.checkRepositoryInTeam(teamID, repoName)
+	found, err := r.checkRepositoryInTeam(client, teamID, repoName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Listing Team Repositories",
@@ -296,6 +300,8 @@ func (r *TeamRepositoryResource) Update(ctx context.Context, req resource.Update
 }
 
 func (r *TeamRepositoryResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state teamRepositoryResourceModel
 	diags := req.State.Get(ctx, &state)
 	resp.Diagnostics.Append(diags...)
@@ -308,7 +314,7 @@ func (r *TeamRepositoryResource) Delete(ctx context.Context, req resource.Delete
 	repoName := state.RepositoryName.ValueString()
 
 	// Get team ID by name
-	teamID, err := r.findTeamByName(org, teamName)
+	teamID, err := r.findTeamByName(client, org, teamName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Listing Teams",
@@ -323,7 +329,7 @@ func (r *TeamRepositoryResource) Delete(ctx context.Context, req resource.Delete
 	}
 
 	// Remove repository from team
-	httpResp, err := r.client.RemoveTeamRepository(teamID, org, repoName)
+	httpResp, err := client.RemoveTeamRepository(teamID, org, repoName)
 	if err != nil {
 		// Handle 404 gracefully - resource already removed
 		if httpResp != nil && httpResp.StatusCode == 404 {
@@ -340,6 +346,8 @@ func (r *TeamRepositoryResource) Delete(ctx context.Context, req resource.Delete
 // ImportState allows importing existing team repository associations.
 // Import format: "org/team_name/repository_name"
 func (r *TeamRepositoryResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
 	// Parse the import ID
 	id := req.ID
 	parts := strings.Split(id, "/")
@@ -383,7 +391,7 @@ func (r *TeamRepositoryResource) ImportState(ctx context.Context, req resource.I
 	}
 
 	// Get team ID by name to verify team exists
-	teamID, err := r.findTeamByName(org, teamName)
+	teamID, err := r.findTeamByName(client, org, teamName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Listing Teams",
@@ -401,7 +409,7 @@ func (r *TeamRepositoryResource) ImportState(ctx context.Context, req resource.I
 	}
 
 	// Verify the repository is assigned to the team
-	found, err := r.checkRepositoryInTeam(teamID, repoName)
+	found, err := r.checkRepositoryInTeam(client, teamID, repoName)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Listing Team Repositories",
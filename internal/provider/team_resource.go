@@ -123,19 +123,21 @@ func (r *teamResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan teamResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -181,7 +183,7 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 		UnitsMap:                unitsMap,
 	}
 
-	team, _, err := r.client.CreateTeam(orgName, opts)
+	team, _, err := client.CreateTeam(orgName, opts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Team",
@@ -196,6 +198,8 @@ func (r *teamResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state teamResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -203,7 +207,7 @@ func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
-	team, httpResp, err := r.client.GetTeam(state.Id.ValueInt64())
+	team, httpResp, err := client.GetTeam(state.Id.ValueInt64())
 	if err != nil {
 		// Handle 404 - team was deleted outside of Terraform
 		if httpResp != nil && httpResp.StatusCode == 404 {
@@ -223,6 +227,8 @@ func (r *teamResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan teamResourceModel
 	var state teamResourceModel
 
@@ -282,7 +288,7 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		UnitsMap:                unitsMap,
 	}
 
-	_, err := r.client.EditTeam(teamID, opts)
+	_, err := client.EditTeam(teamID, opts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating Team",
@@ -292,7 +298,7 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// Read back the updated team
-	team, _, err := r.client.GetTeam(teamID)
+	team, _, err := client.GetTeam(teamID)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Updated Team",
@@ -307,6 +313,8 @@ func (r *teamResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state teamResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -314,7 +322,7 @@ func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 		return
 	}
 
-	_, err := r.client.DeleteTeam(state.Id.ValueInt64())
+	_, err := client.DeleteTeam(state.Id.ValueInt64())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting Team",
@@ -325,6 +333,8 @@ func (r *teamResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *teamResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
 	id, err := strconv.ParseInt(req.ID, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -335,7 +345,7 @@ func (r *teamResource) ImportState(ctx context.Context, req resource.ImportState
 	}
 
 	// Fetch the full team details
-	team, httpResp, err := r.client.GetTeam(id)
+	team, httpResp, err := client.GetTeam(id)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.Diagnostics.AddError(
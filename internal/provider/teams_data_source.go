@@ -88,19 +88,21 @@ func (d *teamsDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
 }
 
 func (d *teamsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	client := d.client.WithContext(ctx)
+
 	var data teamsDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -113,12 +115,12 @@ func (d *teamsDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 	if !data.Org.IsNull() {
 		// List teams for a specific organization
-		teams, _, err = d.client.ListOrgTeams(data.Org.ValueString(), gitea.ListTeamsOptions{
+		teams, _, err = client.ListOrgTeams(data.Org.ValueString(), gitea.ListTeamsOptions{
 			ListOptions: gitea.ListOptions{Page: -1},
 		})
 	} else {
 		// List all teams for the authenticated user
-		teams, _, err = d.client.ListMyTeams(&gitea.ListTeamsOptions{
+		teams, _, err = client.ListMyTeams(&gitea.ListTeamsOptions{
 			ListOptions: gitea.ListOptions{Page: -1},
 		})
 	}
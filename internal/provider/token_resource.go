@@ -35,6 +35,9 @@ type tokenResourceModel struct {
 	Name   types.String `tfsdk:"name"`
 	Scopes types.Set    `tfsdk:"scopes"`
 
+	// Optional
+	Sudo types.String `tfsdk:"sudo"`
+
 	// Computed
 	Id        types.String `tfsdk:"id"`
 	LastEight types.String `tfsdk:"last_eight"`
@@ -69,6 +72,16 @@ func (r *tokenResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				},
 			},
 
+			// Optional
+			"sudo": schema.StringAttribute{
+				Optional:            true,
+				Description:         "Username to impersonate when managing the token.",
+				MarkdownDescription: "Username to impersonate when managing the token. The token is created for this user instead of the authenticated user; requires site administrator credentials.",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+
 			// Computed
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -101,16 +114,16 @@ func (r *tokenResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
 }
 
 func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
@@ -139,7 +152,7 @@ func (r *tokenResource) Create(ctx context.Context, req resource.CreateRequest,
 		Scopes: apiScopes,
 	}
 
-	token, _, err := r.client.CreateAccessToken(opts)
+	token, _, err := clientFor(ctx, r.client, plan.Sudo).CreateAccessToken(opts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating Token",
@@ -171,7 +184,7 @@ func (r *tokenResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	}
 
 	// List all tokens for the user and find the matching one
-	tokens, _, err := r.client.ListAccessTokens(gitea.ListAccessTokensOptions{})
+	tokens, _, err := clientFor(ctx, r.client, state.Sudo).ListAccessTokens(gitea.ListAccessTokensOptions{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading Token",
@@ -231,7 +244,7 @@ func (r *tokenResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
-	httpResp, err := r.client.DeleteAccessToken(tokenID)
+	httpResp, err := clientFor(ctx, r.client, state.Sudo).DeleteAccessToken(tokenID)
 	if err != nil {
 		// If already deleted (404), treat as success
 		if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
@@ -246,8 +259,9 @@ func (r *tokenResource) Delete(ctx context.Context, req resource.DeleteRequest,
 }
 
 func (r *tokenResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Import using the token ID
-	tokenID, err := strconv.ParseInt(req.ID, 10, 64)
+	// Import format: id, or username/id for a token managed through sudo
+	sudo, rawID := splitSudoImportID(req.ID)
+	tokenID, err := strconv.ParseInt(rawID, 10, 64)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Invalid Token ID",
@@ -256,8 +270,13 @@ func (r *tokenResource) ImportState(ctx context.Context, req resource.ImportStat
 		return
 	}
 
+	var data tokenResourceModel
+	if sudo != "" {
+		data.Sudo = types.StringValue(sudo)
+	}
+
 	// List all tokens and find the matching one
-	tokens, _, err := r.client.ListAccessTokens(gitea.ListAccessTokensOptions{})
+	tokens, _, err := clientFor(ctx, r.client, data.Sudo).ListAccessTokens(gitea.ListAccessTokensOptions{})
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Importing Token",
@@ -282,7 +301,6 @@ func (r *tokenResource) ImportState(ctx context.Context, req resource.ImportStat
 		return
 	}
 
-	var data tokenResourceModel
 	r.mapTokenToModel(found, &data)
 
 	// Note: token will be empty for imported tokens since API doesn't return it
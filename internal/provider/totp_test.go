@@ -0,0 +1,75 @@
+package provider
+
+import (
+	"encoding/base32"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+)
+
+// rfc6238Key is the SHA1 test key from RFC 6238 appendix B.
+var rfc6238Key = []byte("12345678901234567890")
+
+func TestTOTPCode_RFC6238Vectors(t *testing.T) {
+	// The RFC lists 8-digit codes; Gitea uses the last 6 digits
+	cases := map[int64]string{
+		59:         "287082",
+		1111111109: "081804",
+		1234567890: "005924",
+		2000000000: "279037",
+	}
+	for unix, want := range cases {
+		if got := totpCode(rfc6238Key, time.Unix(unix, 0)); got != want {
+			t.Errorf("totpCode at %d = %s, want %s", unix, got, want)
+		}
+	}
+}
+
+func TestParseTOTPSecret(t *testing.T) {
+	encoded := base32.StdEncoding.EncodeToString(rfc6238Key)
+
+	for _, secret := range []string{encoded, "gezdgnbvgy3tqojqgezdgnbvgy3tqojq", "GEZD GNBV GY3T QOJQ GEZD GNBV GY3T QOJQ"} {
+		key, err := parseTOTPSecret(secret)
+		if err != nil {
+			t.Fatalf("parseTOTPSecret(%q) returned error: %s", secret, err)
+		}
+		if string(key) != string(rfc6238Key) {
+			t.Errorf("parseTOTPSecret(%q) = %q, want %q", secret, key, rfc6238Key)
+		}
+	}
+
+	if _, err := parseTOTPSecret("not base32!"); err == nil {
+		t.Error("expected an error for an invalid secret")
+	}
+}
+
+func TestTOTPTransport_OnlyForBasicAuth(t *testing.T) {
+	var otps []string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		otps = append(otps, r.Header.Get("X-Gitea-Otp"))
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &totpTransport{next: http.DefaultTransport, key: rfc6238Key}}
+
+	basic, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	basic.SetBasicAuth("root", "admin1234")
+	token, _ := http.NewRequest(http.MethodGet, server.URL, nil)
+	token.Header.Set("Authorization", "token abc")
+
+	for _, req := range []*http.Request{basic, token} {
+		resp, err := client.Do(req)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	if len(otps) != 2 || len(otps[0]) != totpDigits || otps[1] != "" {
+		t.Fatalf("expected an OTP only on the basic auth request, got %q", otps)
+	}
+	if basic.Header.Get("X-Gitea-Otp") != "" {
+		t.Error("expected the caller's request to be left unmodified")
+	}
+}
@@ -0,0 +1,476 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"golang.org/x/net/http/httpproxy"
+)
+
+// headerNameRegexp matches valid HTTP header field names (RFC 9110 tokens).
+var headerNameRegexp = regexp.MustCompile("^[!#$%&'*+.^_`|~0-9A-Za-z-]+$")
+
+// buildTransport assembles the transport shared by all API requests. From the
+// outside in, requests are retried, throttled, logged, given a one-time
+// password when totpKey is set, given the configured headers and sent through
+// the proxy, if any, using tlsConfig. Logging sits inside the retries so that
+// every attempt is logged, and outside the one-time password and configured
+// headers so that their values never reach the log.
+func buildTransport(data *giteaProviderModel, tlsConfig *tls.Config, totpKey []byte) (http.RoundTripper, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	base := &http.Transport{
+		TLSClientConfig: tlsConfig,
+	}
+
+	// Proxy-* headers are meant for the proxy alone and are kept apart so
+	// they never reach the Gitea server
+	headers := make(http.Header)
+	proxyHeaders := make(http.Header)
+	if !data.Headers.IsNull() {
+		var configured map[string]string
+		diags.Append(data.Headers.ElementsAs(context.Background(), &configured, false)...)
+		for name, value := range configured {
+			if strings.HasPrefix(http.CanonicalHeaderKey(name), "Proxy-") {
+				proxyHeaders.Set(name, value)
+				continue
+			}
+			headers.Set(name, value)
+		}
+	}
+
+	if proxyURL := data.ProxyURL.ValueString(); proxyURL != "" {
+		proxy, err := proxyFunc(proxyURL, data.NoProxy.ValueString())
+		if err != nil {
+			diags.AddAttributeError(path.Root("proxy_url"), "Invalid Proxy URL",
+				fmt.Sprintf("Could not use proxy URL: %s", err.Error()))
+		}
+		base.Proxy = proxy
+		if len(proxyHeaders) > 0 {
+			base.ProxyConnectHeader = proxyHeaders
+		}
+	}
+
+	var transport http.RoundTripper = base
+	if len(headers) > 0 || base.ProxyConnectHeader != nil {
+		transport = &headerTransport{
+			next:         transport,
+			headers:      headers,
+			proxy:        base.Proxy,
+			proxyHeaders: base.ProxyConnectHeader,
+		}
+	}
+	if len(totpKey) > 0 {
+		transport = &totpTransport{next: transport, key: totpKey}
+	}
+	if httpDebugLogEnabled() {
+		transport = &loggingTransport{next: transport}
+	}
+
+	// Each retry of a transient failure is throttled again
+	transport = newThrottleTransport(
+		transport,
+		data.RequestsPerSecond.ValueFloat64(),
+		int(data.MaxConcurrentRequests.ValueInt64()),
+	)
+
+	retry, retryDiags := newRetryTransport(transport, data)
+	diags.Append(retryDiags...)
+
+	return retry, diags
+}
+
+// Retry defaults used when the provider block leaves the settings unset.
+const (
+	defaultMaxRetries   = 3
+	defaultRetryWaitMin = time.Second
+	defaultRetryWaitMax = 30 * time.Second
+)
+
+var defaultRetryableStatusCodes = []int{
+	http.StatusTooManyRequests,
+	http.StatusBadGateway,
+	http.StatusServiceUnavailable,
+	http.StatusGatewayTimeout,
+}
+
+type retrySafeKey struct{}
+
+// retryRecord notes whether a request marked with markRetrySafe was sent more
+// than once, so the caller can tell a conflict with its own earlier attempt
+// from a real one.
+type retryRecord struct {
+	retried atomic.Bool
+}
+
+// markRetrySafe marks requests sent with the returned context as safe to
+// repeat, regardless of their HTTP method. Only use it for calls that cannot
+// create a duplicate when repeated, such as a POST to a path naming a unique
+// object, and check wasRetried when such a call fails with a conflict.
+func markRetrySafe(ctx context.Context) context.Context {
+	return context.WithValue(ctx, retrySafeKey{}, &retryRecord{})
+}
+
+// wasRetried reports whether a request sent with ctx, as returned by
+// markRetrySafe, was repeated after a failed attempt.
+func wasRetried(ctx context.Context) bool {
+	record, _ := ctx.Value(retrySafeKey{}).(*retryRecord)
+	return record != nil && record.retried.Load()
+}
+
+// retryTransport retries requests that fail with a transport error or a
+// retryable status code, waiting with exponential backoff between attempts.
+// Non-idempotent requests are only retried when marked with markRetrySafe.
+type retryTransport struct {
+	next            http.RoundTripper
+	maxRetries      int
+	waitMin         time.Duration
+	waitMax         time.Duration
+	retryableStatus map[int]bool
+}
+
+// newRetryTransport builds a retryTransport around next from the retry
+// settings in the provider configuration, applying defaults for unset values.
+func newRetryTransport(next http.RoundTripper, data *giteaProviderModel) (*retryTransport, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	t := &retryTransport{
+		next:            next,
+		maxRetries:      defaultMaxRetries,
+		waitMin:         defaultRetryWaitMin,
+		waitMax:         defaultRetryWaitMax,
+		retryableStatus: make(map[int]bool),
+	}
+
+	if !data.MaxRetries.IsNull() {
+		t.maxRetries = int(data.MaxRetries.ValueInt64())
+	}
+
+	if v := data.RetryWaitMin.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			diags.AddAttributeError(path.Root("retry_wait_min"), "Invalid Retry Wait",
+				fmt.Sprintf("Expected a non-negative duration such as \"500ms\" or \"2s\", got: %s", v))
+		}
+		t.waitMin = d
+	}
+
+	if v := data.RetryWaitMax.ValueString(); v != "" {
+		d, err := time.ParseDuration(v)
+		if err != nil || d < 0 {
+			diags.AddAttributeError(path.Root("retry_wait_max"), "Invalid Retry Wait",
+				fmt.Sprintf("Expected a non-negative duration such as \"500ms\" or \"2s\", got: %s", v))
+		}
+		t.waitMax = d
+	}
+
+	if !diags.HasError() && t.waitMax < t.waitMin {
+		diags.AddAttributeError(path.Root("retry_wait_max"), "Invalid Retry Wait",
+			fmt.Sprintf("retry_wait_max (%s) must not be shorter than retry_wait_min (%s).", t.waitMax, t.waitMin))
+	}
+
+	codes := defaultRetryableStatusCodes
+	if !data.RetryableStatusCodes.IsNull() {
+		var configured []int64
+		diags.Append(data.RetryableStatusCodes.ElementsAs(context.Background(), &configured, false)...)
+		codes = make([]int, len(configured))
+		for i, code := range configured {
+			codes[i] = int(code)
+		}
+	}
+	for _, code := range codes {
+		t.retryableStatus[code] = true
+	}
+
+	return t, diags
+}
+
+func (t *retryTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if !t.canRetry(req) {
+		return t.next.RoundTrip(req)
+	}
+
+	ctx := req.Context()
+	attemptReq := req
+	for attempt := 0; ; attempt++ {
+		if attempt > 0 && req.GetBody != nil {
+			body, err := req.GetBody()
+			if err != nil {
+				return nil, err
+			}
+			attemptReq = req.Clone(ctx)
+			attemptReq.Body = body
+		}
+
+		resp, err := t.next.RoundTrip(attemptReq)
+		if attempt >= t.maxRetries || !t.shouldRetry(ctx, resp, err) {
+			return resp, err
+		}
+
+		wait, ok := t.backoff(attempt, resp)
+		if !ok {
+			return resp, err
+		}
+
+		reason := ""
+		if err != nil {
+			reason = err.Error()
+		} else {
+			reason = resp.Status
+			// Drain the body so the connection can be reused
+			_, _ = io.Copy(io.Discard, resp.Body)
+			resp.Body.Close()
+		}
+
+		tflog.Debug(ctx, "Retrying Gitea API request", map[string]interface{}{
+			"method":  req.Method,
+			"path":    req.URL.Path,
+			"attempt": attempt + 1,
+			"wait":    wait.String(),
+			"reason":  reason,
+		})
+
+		if record, ok := ctx.Value(retrySafeKey{}).(*retryRecord); ok {
+			record.retried.Store(true)
+		}
+
+		timer := time.NewTimer(wait)
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return nil, ctx.Err()
+		case <-timer.C:
+		}
+	}
+}
+
+// canRetry reports whether req may be sent more than once.
+func (t *retryTransport) canRetry(req *http.Request) bool {
+	if t.maxRetries <= 0 {
+		return false
+	}
+
+	// A consumed body can only be replayed if it can be recreated
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return false
+	}
+
+	switch req.Method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions, http.MethodPut, http.MethodDelete:
+		return true
+	}
+
+	_, safe := req.Context().Value(retrySafeKey{}).(*retryRecord)
+	return safe
+}
+
+// shouldRetry reports whether an attempt failed in a way worth retrying.
+func (t *retryTransport) shouldRetry(ctx context.Context, resp *http.Response, err error) bool {
+	if err != nil {
+		return ctx.Err() == nil
+	}
+	return t.retryableStatus[resp.StatusCode]
+}
+
+// backoff returns how long to wait before the retry following attempt. A
+// Retry-After header from the server takes precedence; when it asks for a
+// longer wait than waitMax, retrying stops and false is returned.
+func (t *retryTransport) backoff(attempt int, resp *http.Response) (time.Duration, bool) {
+	if resp != nil {
+		if wait, ok := parseRetryAfter(resp.Header.Get("Retry-After")); ok {
+			if wait > t.waitMax {
+				return 0, false
+			}
+			return wait, true
+		}
+	}
+
+	wait := t.waitMin
+	for i := 0; i < attempt && wait < t.waitMax; i++ {
+		wait *= 2
+	}
+	if wait > t.waitMax {
+		wait = t.waitMax
+	}
+	return wait, true
+}
+
+// parseRetryAfter parses a Retry-After header value given either in seconds
+// or as an HTTP date.
+func parseRetryAfter(value string) (time.Duration, bool) {
+	if value == "" {
+		return 0, false
+	}
+	if seconds, err := strconv.Atoi(value); err == nil && seconds >= 0 {
+		return time.Duration(seconds) * time.Second, true
+	}
+	if date, err := http.ParseTime(value); err == nil {
+		wait := time.Until(date)
+		if wait < 0 {
+			wait = 0
+		}
+		return wait, true
+	}
+	return 0, false
+}
+
+// throttleTransport caps the number of requests in flight and spaces requests
+// out to a maximum rate. A single instance is shared by every resource, so
+// the limits hold across Terraform's parallel operations.
+type throttleTransport struct {
+	next     http.RoundTripper
+	interval time.Duration
+	slots    chan struct{}
+
+	mutex  sync.Mutex
+	nextAt time.Time
+}
+
+// newThrottleTransport returns next wrapped in a throttleTransport, or next
+// itself when neither limit is set. A requestsPerSecond or maxConcurrent of
+// zero leaves that limit off.
+func newThrottleTransport(next http.RoundTripper, requestsPerSecond float64, maxConcurrent int) http.RoundTripper {
+	if requestsPerSecond <= 0 && maxConcurrent <= 0 {
+		return next
+	}
+
+	t := &throttleTransport{next: next}
+	if requestsPerSecond > 0 {
+		t.interval = time.Duration(float64(time.Second) / requestsPerSecond)
+	}
+	if maxConcurrent > 0 {
+		t.slots = make(chan struct{}, maxConcurrent)
+	}
+	return t
+}
+
+func (t *throttleTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+	start := time.Now()
+
+	// The slot is held until the response headers arrive
+	if t.slots != nil {
+		select {
+		case t.slots <- struct{}{}:
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		}
+		defer func() { <-t.slots }()
+	}
+
+	if t.interval > 0 {
+		if wait := t.reserve(); wait > 0 {
+			timer := time.NewTimer(wait)
+			select {
+			case <-ctx.Done():
+				timer.Stop()
+				return nil, ctx.Err()
+			case <-timer.C:
+			}
+		}
+	}
+
+	if waited := time.Since(start); waited >= time.Millisecond {
+		tflog.Debug(ctx, "Throttled Gitea API request", map[string]interface{}{
+			"method": req.Method,
+			"path":   req.URL.Path,
+			"wait":   waited.String(),
+		})
+	}
+
+	return t.next.RoundTrip(req)
+}
+
+// reserve claims the next free send time and returns how long to wait for it.
+func (t *throttleTransport) reserve() time.Duration {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	now := time.Now()
+	at := t.nextAt
+	if at.Before(now) {
+		at = now
+	}
+	t.nextAt = at.Add(t.interval)
+	return at.Sub(now)
+}
+
+// proxyFunc returns the Transport.Proxy function sending requests through
+// proxyURL, except for hosts matched by noProxy. noProxy uses the syntax of
+// the NO_PROXY environment variable.
+func proxyFunc(proxyURL, noProxy string) (func(*http.Request) (*url.URL, error), error) {
+	parsed, err := url.Parse(proxyURL)
+	if err != nil {
+		return nil, err
+	}
+	switch parsed.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return nil, fmt.Errorf("unsupported proxy scheme %q, expected http, https or socks5", parsed.Scheme)
+	}
+
+	config := &httpproxy.Config{
+		HTTPProxy:  proxyURL,
+		HTTPSProxy: proxyURL,
+		NoProxy:    noProxy,
+	}
+	proxy := config.ProxyFunc()
+	return func(req *http.Request) (*url.URL, error) {
+		return proxy(req.URL)
+	}, nil
+}
+
+// headerTransport adds static headers to every request. Headers the request
+// already carries, such as Authorization, are left untouched. proxyHeaders
+// are only added to plain HTTP requests forwarded by an HTTP proxy, as those
+// are read by the proxy itself; HTTPS requests carry them in the CONNECT
+// request instead.
+type headerTransport struct {
+	next         http.RoundTripper
+	headers      http.Header
+	proxy        func(*http.Request) (*url.URL, error)
+	proxyHeaders http.Header
+}
+
+func (t *headerTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, values := range t.headers {
+		if _, ok := req.Header[name]; !ok {
+			req.Header[name] = values
+		}
+	}
+	if len(t.proxyHeaders) > 0 && req.URL.Scheme == "http" && t.forwardedByProxy(req) {
+		for name, values := range t.proxyHeaders {
+			if _, ok := req.Header[name]; !ok {
+				req.Header[name] = values
+			}
+		}
+	}
+	return t.next.RoundTrip(req)
+}
+
+// forwardedByProxy reports whether req is sent to an HTTP proxy rather than
+// tunneled, as through a SOCKS5 proxy, or sent directly.
+func (t *headerTransport) forwardedByProxy(req *http.Request) bool {
+	if t.proxy == nil {
+		return false
+	}
+	proxyURL, err := t.proxy(req)
+	if err != nil || proxyURL == nil {
+		return false
+	}
+	return proxyURL.Scheme == "http" || proxyURL.Scheme == "https"
+}
@@ -0,0 +1,217 @@
+package provider
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// httpLogSubsystem is the tflog subsystem API requests are logged to. Its
+// level can be set on its own with TF_LOG_PROVIDER_GITEA_HTTP.
+const httpLogSubsystem = "http"
+
+// providerLogEnv is the environment variable Terraform reads the provider's
+// log level from. Subsystem levels append the subsystem name to it.
+const providerLogEnv = "TF_LOG_PROVIDER_GITEA"
+
+// httpDebugLogEnabled reports whether any of the environment variables that
+// set the level of the http subsystem asks for debug logs or more. Without
+// them the request and response bodies are not captured at all.
+func httpDebugLogEnabled() bool {
+	for _, name := range []string{"TF_LOG", "TF_LOG_PROVIDER", providerLogEnv, providerLogEnv + "_" + strings.ToUpper(httpLogSubsystem)} {
+		switch strings.ToUpper(strings.TrimSpace(os.Getenv(name))) {
+		case "TRACE", "DEBUG", "JSON":
+			return true
+		}
+	}
+	return false
+}
+
+// maxLoggedBodySize caps how much of a request or response body is logged.
+const maxLoggedBodySize = 8 * 1024
+
+const redacted = "[REDACTED]"
+
+// sensitiveHeaders are never logged with their value.
+var sensitiveHeaders = []string{
+	"Authorization",
+	"Proxy-Authorization",
+	"Cookie",
+	"Set-Cookie",
+	"Signature",
+	"X-Gitea-Otp",
+}
+
+// sensitiveKeyParts marks a JSON field or query parameter as secret when its
+// lowercased name contains one of them.
+var sensitiveKeyParts = []string{
+	"password",
+	"passwd",
+	"passphrase",
+	"secret",
+	"token",
+	"private_key",
+	"authorization",
+	"credential",
+}
+
+// sensitiveKeys are JSON field names that hold secrets without saying so:
+// access token values ("sha1"), action secret values ("data") and OTPs.
+var sensitiveKeys = map[string]bool{
+	"sha1": true,
+	"data": true,
+	"otp":  true,
+}
+
+// loggingTransport logs every API request and response to the http tflog
+// subsystem at debug level, with credentials and secrets redacted. It is only
+// installed when httpDebugLogEnabled, since it buffers every body it logs.
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := tflog.NewSubsystem(req.Context(), httpLogSubsystem, tflog.WithLevelFromEnv(providerLogEnv, httpLogSubsystem))
+
+	requestFields := map[string]interface{}{
+		"method":  req.Method,
+		"path":    redactURL(req.URL),
+		"headers": redactHeaders(req.Header),
+	}
+	if req.GetBody != nil {
+		if body, err := req.GetBody(); err == nil {
+			requestFields["body"] = loggableBody(body, req.Header)
+			body.Close()
+		}
+	}
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Sending Gitea API request", requestFields)
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	duration := time.Since(start)
+
+	if err != nil {
+		tflog.SubsystemDebug(ctx, httpLogSubsystem, "Gitea API request failed", map[string]interface{}{
+			"method":   req.Method,
+			"path":     redactURL(req.URL),
+			"duration": duration.String(),
+			"error":    err.Error(),
+		})
+		return resp, err
+	}
+
+	// Only the head of the body is read; the caller still gets all of it
+	head, readErr := io.ReadAll(io.LimitReader(resp.Body, maxLoggedBodySize+1))
+	resp.Body = &replayBody{Reader: io.MultiReader(bytes.NewReader(head), resp.Body), Closer: resp.Body}
+
+	responseFields := map[string]interface{}{
+		"method":   req.Method,
+		"path":     redactURL(req.URL),
+		"status":   resp.StatusCode,
+		"duration": duration.String(),
+		"headers":  redactHeaders(resp.Header),
+	}
+	if readErr == nil {
+		responseFields["body"] = loggableBody(io.NopCloser(bytes.NewReader(head)), resp.Header)
+	}
+	tflog.SubsystemDebug(ctx, httpLogSubsystem, "Received Gitea API response", responseFields)
+
+	return resp, nil
+}
+
+// replayBody serves a response body whose head has already been read.
+type replayBody struct {
+	io.Reader
+	io.Closer
+}
+
+// loggableBody returns a redacted rendering of a JSON body. Other content is
+// summarized by type only, since it cannot be redacted reliably.
+func loggableBody(body io.ReadCloser, header http.Header) string {
+	data, err := io.ReadAll(io.LimitReader(body, maxLoggedBodySize+1))
+	if err != nil || len(data) == 0 {
+		return ""
+	}
+	if len(data) > maxLoggedBodySize {
+		return fmt.Sprintf("[body of more than %d bytes omitted]", maxLoggedBodySize)
+	}
+
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		return fmt.Sprintf("[%d bytes of %s omitted]", len(data), header.Get("Content-Type"))
+	}
+
+	redactedBody, err := json.Marshal(redactJSON(value))
+	if err != nil {
+		return ""
+	}
+	return string(redactedBody)
+}
+
+// redactJSON replaces the string values of sensitive fields in a decoded JSON
+// document, recursing into nested objects and arrays.
+func redactJSON(value interface{}) interface{} {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for key, field := range v {
+			if _, isString := field.(string); isString && isSensitiveKey(key) {
+				v[key] = redacted
+				continue
+			}
+			v[key] = redactJSON(field)
+		}
+	case []interface{}:
+		for i := range v {
+			v[i] = redactJSON(v[i])
+		}
+	}
+	return value
+}
+
+// redactHeaders returns a copy of header with credential values redacted.
+func redactHeaders(header http.Header) http.Header {
+	clone := header.Clone()
+	for _, name := range sensitiveHeaders {
+		if _, ok := clone[name]; ok {
+			clone[name] = []string{redacted}
+		}
+	}
+	return clone
+}
+
+// redactURL returns the path and query of u with sensitive query parameters,
+// such as access_token, redacted.
+func redactURL(u *url.URL) string {
+	if u.RawQuery == "" {
+		return u.Path
+	}
+
+	query := u.Query()
+	for key := range query {
+		if isSensitiveKey(key) {
+			query[key] = []string{redacted}
+		}
+	}
+	return u.Path + "?" + query.Encode()
+}
+
+func isSensitiveKey(key string) bool {
+	key = strings.ToLower(key)
+	if sensitiveKeys[key] {
+		return true
+	}
+	for _, part := range sensitiveKeyParts {
+		if strings.Contains(key, part) {
+			return true
+		}
+	}
+	return false
+}
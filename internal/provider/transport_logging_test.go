@@ -0,0 +1,95 @@
+package provider
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransport_RedactsSecrets(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"id":7,"name":"ci","sha1":"token-value-123","token_last_eight":"alue-123"}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	body := `{"username":"alice","password":"hunter2","data":"secret-value","teams":{"data":[{"name":"owners"}]}}`
+	req, _ := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/v1/admin/users?access_token=query-token", strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Authorization", "token header-token")
+
+	resp, err := (&http.Client{Transport: &loggingTransport{next: http.DefaultTransport}}).Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	respBody, _ := io.ReadAll(resp.Body)
+	resp.Body.Close()
+
+	if !strings.Contains(string(respBody), "token-value-123") {
+		t.Fatalf("expected the caller to receive the full response body, got %s", respBody)
+	}
+
+	logs := output.String()
+	for _, secret := range []string{"hunter2", "secret-value", "header-token", "query-token", "token-value-123"} {
+		if strings.Contains(logs, secret) {
+			t.Errorf("expected %q to be redacted from the logs:\n%s", secret, logs)
+		}
+	}
+	for _, want := range []string{`"@module":"provider.http"`, `"method":"POST"`, `"status":201`, `"path":"/api/v1/admin/users`, "alice", "owners", "duration"} {
+		if !strings.Contains(logs, want) {
+			t.Errorf("expected logs to contain %s:\n%s", want, logs)
+		}
+	}
+}
+
+func TestHTTPDebugLogEnabled(t *testing.T) {
+	for _, name := range []string{"TF_LOG", "TF_LOG_PROVIDER", "TF_LOG_PROVIDER_GITEA", "TF_LOG_PROVIDER_GITEA_HTTP"} {
+		t.Setenv(name, "")
+	}
+	if httpDebugLogEnabled() {
+		t.Fatal("expected debug logging to be off without log settings")
+	}
+
+	t.Setenv("TF_LOG", "info")
+	if httpDebugLogEnabled() {
+		t.Fatal("expected debug logging to be off at info level")
+	}
+
+	t.Setenv("TF_LOG_PROVIDER_GITEA_HTTP", "debug")
+	if !httpDebugLogEnabled() {
+		t.Fatal("expected TF_LOG_PROVIDER_GITEA_HTTP=debug to enable debug logging")
+	}
+
+	t.Setenv("TF_LOG_PROVIDER_GITEA_HTTP", "")
+	t.Setenv("TF_LOG", "JSON")
+	if !httpDebugLogEnabled() {
+		t.Fatal("expected TF_LOG=JSON to enable debug logging")
+	}
+}
+
+func TestRedactURL(t *testing.T) {
+	u, _ := url.Parse("https://gitea.example.com/api/v1/repos?token=abc&page=2")
+	got := redactURL(u)
+	if strings.Contains(got, "abc") || !strings.Contains(got, "page=2") {
+		t.Fatalf("expected only the token parameter to be redacted, got %s", got)
+	}
+}
+
+func TestLoggableBody_NonJSON(t *testing.T) {
+	header := http.Header{"Content-Type": []string{"text/plain"}}
+	got := loggableBody(io.NopCloser(strings.NewReader("password=hunter2")), header)
+	if strings.Contains(got, "hunter2") {
+		t.Fatalf("expected a non-JSON body to be omitted, got %s", got)
+	}
+}
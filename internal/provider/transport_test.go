@@ -0,0 +1,408 @@
+package provider
+
+import (
+	"context"
+	"crypto/tls"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// newTestRetryTransport returns a retryTransport with waits short enough for
+// unit tests.
+func newTestRetryTransport(maxRetries int) *retryTransport {
+	return &retryTransport{
+		next:            http.DefaultTransport,
+		maxRetries:      maxRetries,
+		waitMin:         time.Millisecond,
+		waitMax:         10 * time.Millisecond,
+		retryableStatus: map[int]bool{http.StatusBadGateway: true, http.StatusTooManyRequests: true},
+	}
+}
+
+// failingServer responds with status to the first failures requests and with
+// 200 afterwards. It records the number of requests and the last body seen.
+func failingServer(t *testing.T, failures, status int, header http.Header) (*httptest.Server, *int, *string) {
+	t.Helper()
+	var calls int
+	var lastBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		body, _ := io.ReadAll(r.Body)
+		lastBody = string(body)
+		if calls <= failures {
+			for k, v := range header {
+				w.Header()[k] = v
+			}
+			w.WriteHeader(status)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	return server, &calls, &lastBody
+}
+
+func TestRetryTransport_RetriesIdempotentRequests(t *testing.T) {
+	server, calls, _ := failingServer(t, 2, http.StatusBadGateway, nil)
+	client := &http.Client{Transport: newTestRetryTransport(3)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || *calls != 3 {
+		t.Fatalf("expected 200 after 3 calls, got %d after %d calls", resp.StatusCode, *calls)
+	}
+}
+
+func TestRetryTransport_GivesUpAfterMaxRetries(t *testing.T) {
+	server, calls, _ := failingServer(t, 10, http.StatusBadGateway, nil)
+	client := &http.Client{Transport: newTestRetryTransport(2)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway || *calls != 3 {
+		t.Fatalf("expected 502 after 3 calls, got %d after %d calls", resp.StatusCode, *calls)
+	}
+}
+
+func TestRetryTransport_DoesNotRetryPost(t *testing.T) {
+	server, calls, _ := failingServer(t, 1, http.StatusBadGateway, nil)
+	client := &http.Client{Transport: newTestRetryTransport(3)}
+
+	resp, err := client.Post(server.URL, "application/json", strings.NewReader(`{"name":"x"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusBadGateway || *calls != 1 {
+		t.Fatalf("expected a single 502, got %d after %d calls", resp.StatusCode, *calls)
+	}
+}
+
+func TestRetryTransport_RetriesPostMarkedSafe(t *testing.T) {
+	server, calls, lastBody := failingServer(t, 1, http.StatusBadGateway, nil)
+	client := &http.Client{Transport: newTestRetryTransport(3)}
+
+	ctx := markRetrySafe(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(`{"name":"x"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK || *calls != 2 {
+		t.Fatalf("expected 200 after 2 calls, got %d after %d calls", resp.StatusCode, *calls)
+	}
+	if *lastBody != `{"name":"x"}` {
+		t.Fatalf("expected the body to be replayed, got %q", *lastBody)
+	}
+	if !wasRetried(ctx) {
+		t.Fatal("expected the request to be recorded as retried")
+	}
+}
+
+func TestRetryTransport_WasRetriedWithoutRetry(t *testing.T) {
+	server, _, _ := failingServer(t, 0, http.StatusBadGateway, nil)
+	client := &http.Client{Transport: newTestRetryTransport(3)}
+
+	ctx := markRetrySafe(context.Background())
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL, strings.NewReader(`{"name":"x"}`))
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if wasRetried(ctx) {
+		t.Fatal("expected a request that succeeded at once not to be recorded as retried")
+	}
+	if wasRetried(context.Background()) {
+		t.Fatal("expected an unmarked context not to be recorded as retried")
+	}
+}
+
+func TestRetryTransport_RetryAfterBeyondMaxStops(t *testing.T) {
+	server, calls, _ := failingServer(t, 1, http.StatusTooManyRequests, http.Header{"Retry-After": []string{"120"}})
+	client := &http.Client{Transport: newTestRetryTransport(3)}
+
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if resp.StatusCode != http.StatusTooManyRequests || *calls != 1 {
+		t.Fatalf("expected a single 429, got %d after %d calls", resp.StatusCode, *calls)
+	}
+}
+
+func TestRetryTransport_Backoff(t *testing.T) {
+	transport := &retryTransport{waitMin: time.Second, waitMax: 5 * time.Second}
+
+	for attempt, want := range []time.Duration{time.Second, 2 * time.Second, 4 * time.Second, 5 * time.Second} {
+		if got, _ := transport.backoff(attempt, nil); got != want {
+			t.Errorf("backoff(%d) = %s, want %s", attempt, got, want)
+		}
+	}
+
+	resp := &http.Response{Header: http.Header{"Retry-After": []string{"3"}}}
+	if got, ok := transport.backoff(0, resp); !ok || got != 3*time.Second {
+		t.Errorf("expected Retry-After of 3s to be honored, got %s (%t)", got, ok)
+	}
+}
+
+func TestNewRetryTransport_Defaults(t *testing.T) {
+	data := &giteaProviderModel{
+		RetryableStatusCodes: types.ListNull(types.Int64Type),
+	}
+
+	transport, diags := newRetryTransport(http.DefaultTransport, data)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	if transport.maxRetries != defaultMaxRetries || transport.waitMin != defaultRetryWaitMin || transport.waitMax != defaultRetryWaitMax {
+		t.Errorf("expected default settings, got %+v", transport)
+	}
+	for _, code := range defaultRetryableStatusCodes {
+		if !transport.retryableStatus[code] {
+			t.Errorf("expected status %d to be retryable by default", code)
+		}
+	}
+}
+
+func TestNewRetryTransport_InvalidSettings(t *testing.T) {
+	data := &giteaProviderModel{
+		RetryWaitMin:         types.StringValue("10s"),
+		RetryWaitMax:         types.StringValue("1s"),
+		RetryableStatusCodes: types.ListValueMust(types.Int64Type, []attr.Value{types.Int64Value(500)}),
+	}
+	if _, diags := newRetryTransport(http.DefaultTransport, data); !diags.HasError() {
+		t.Error("expected an error when retry_wait_max is shorter than retry_wait_min")
+	}
+
+	data.RetryWaitMin = types.StringValue("soon")
+	if _, diags := newRetryTransport(http.DefaultTransport, data); !diags.HasError() {
+		t.Error("expected an error for an unparsable retry_wait_min")
+	}
+}
+
+func TestThrottleTransport_CapsConcurrentRequests(t *testing.T) {
+	var mutex sync.Mutex
+	var inFlight, maxInFlight int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		mutex.Lock()
+		inFlight++
+		if inFlight > maxInFlight {
+			maxInFlight = inFlight
+		}
+		mutex.Unlock()
+
+		time.Sleep(5 * time.Millisecond)
+
+		mutex.Lock()
+		inFlight--
+		mutex.Unlock()
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newThrottleTransport(http.DefaultTransport, 0, 2)}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 8; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			resp, err := client.Get(server.URL)
+			if err != nil {
+				t.Errorf("unexpected error: %s", err)
+				return
+			}
+			resp.Body.Close()
+		}()
+	}
+	wg.Wait()
+
+	if maxInFlight > 2 {
+		t.Fatalf("expected at most 2 requests in flight, saw %d", maxInFlight)
+	}
+}
+
+func TestThrottleTransport_LimitsRate(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	client := &http.Client{Transport: newThrottleTransport(http.DefaultTransport, 50, 0)}
+
+	start := time.Now()
+	for i := 0; i < 3; i++ {
+		resp, err := client.Get(server.URL)
+		if err != nil {
+			t.Fatalf("unexpected error: %s", err)
+		}
+		resp.Body.Close()
+	}
+
+	// Three requests at 50 per second need at least two 20ms intervals
+	if elapsed := time.Since(start); elapsed < 40*time.Millisecond {
+		t.Fatalf("expected requests to be spaced out, 3 took only %s", elapsed)
+	}
+}
+
+func TestThrottleTransport_DisabledByDefault(t *testing.T) {
+	if transport := newThrottleTransport(http.DefaultTransport, 0, 0); transport != http.DefaultTransport {
+		t.Fatalf("expected the base transport when no limits are set, got %T", transport)
+	}
+}
+
+func TestBuildTransport_ProxyAndHeaders(t *testing.T) {
+	// The Gitea server records the proxy credentials it sees, which must be none
+	var originProxyAuthorizations []string
+	origin := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		originProxyAuthorizations = append(originProxyAuthorizations, r.Header.Get("Proxy-Authorization"))
+	}))
+	defer origin.Close()
+
+	var gotHost, gotTenant, gotAuthorization, gotProxyAuthorization, gotConnectAuthorization string
+	proxy := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method == http.MethodConnect {
+			// Tunnel every HTTPS request to the Gitea server
+			gotConnectAuthorization = r.Header.Get("Proxy-Authorization")
+			upstream, err := net.Dial("tcp", origin.Listener.Addr().String())
+			if err != nil {
+				w.WriteHeader(http.StatusBadGateway)
+				return
+			}
+			conn, _, err := http.NewResponseController(w).Hijack()
+			if err != nil {
+				upstream.Close()
+				return
+			}
+			_, _ = conn.Write([]byte("HTTP/1.1 200 Connection Established\r\n\r\n"))
+			go func() {
+				_, _ = io.Copy(upstream, conn)
+				upstream.Close()
+			}()
+			_, _ = io.Copy(conn, upstream)
+			conn.Close()
+			return
+		}
+		gotHost = r.URL.Host
+		gotTenant = r.Header.Get("X-Tenant")
+		gotAuthorization = r.Header.Get("Authorization")
+		gotProxyAuthorization = r.Header.Get("Proxy-Authorization")
+	}))
+	defer proxy.Close()
+
+	transport, diags := buildTransport(&giteaProviderModel{
+		ProxyURL: types.StringValue(proxy.URL),
+		NoProxy:  types.StringValue("127.0.0.1"),
+		Headers: types.MapValueMust(types.StringType, map[string]attr.Value{
+			"x-tenant":            types.StringValue("acme"),
+			"Authorization":       types.StringValue("overridden"),
+			"proxy-authorization": types.StringValue("Basic cHJveHk6c2VjcmV0"),
+		}),
+		MaxRetries: types.Int64Value(0),
+	}, &tls.Config{InsecureSkipVerify: true}, nil)
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+	client := &http.Client{Transport: transport}
+
+	req, _ := http.NewRequest(http.MethodGet, "http://gitea.invalid/api/v1/version", nil)
+	req.Header.Set("Authorization", "token secret")
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	resp.Body.Close()
+
+	if gotHost != "gitea.invalid" {
+		t.Errorf("expected the request to go through the proxy, proxy saw host %q", gotHost)
+	}
+	if gotTenant != "acme" {
+		t.Errorf("expected X-Tenant header to be added, got %q", gotTenant)
+	}
+	if gotAuthorization != "token secret" {
+		t.Errorf("expected the request's own Authorization header to take precedence, got %q", gotAuthorization)
+	}
+	if gotProxyAuthorization != "Basic cHJveHk6c2VjcmV0" {
+		t.Errorf("expected the proxy to receive Proxy-Authorization with a plain HTTP request, got %q", gotProxyAuthorization)
+	}
+
+	// HTTPS through the proxy tunnel, and directly for a no_proxy host
+	for _, target := range []string{"https://gitea.invalid/api/v1/version", origin.URL + "/api/v1/version"} {
+		resp, err := client.Get(target)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %s", target, err)
+		}
+		resp.Body.Close()
+	}
+
+	if gotConnectAuthorization != "Basic cHJveHk6c2VjcmV0" {
+		t.Errorf("expected the proxy to receive Proxy-Authorization with the CONNECT request, got %q", gotConnectAuthorization)
+	}
+	if len(originProxyAuthorizations) != 2 {
+		t.Fatalf("expected 2 requests at the Gitea server, got %d", len(originProxyAuthorizations))
+	}
+	for _, got := range originProxyAuthorizations {
+		if got != "" {
+			t.Errorf("expected the Gitea server never to receive Proxy-Authorization, got %q", got)
+		}
+	}
+}
+
+func TestBuildTransport_InvalidProxyURL(t *testing.T) {
+	_, diags := buildTransport(&giteaProviderModel{
+		ProxyURL: types.StringValue("ftp://proxy.example.com"),
+	}, nil, nil)
+	if !diags.HasError() {
+		t.Fatal("expected an error for an unsupported proxy scheme")
+	}
+}
+
+func TestProxyFunc_NoProxy(t *testing.T) {
+	proxy, err := proxyFunc("http://proxy.example.com:3128", "gitea.internal,10.0.0.0/8")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	cases := map[string]bool{
+		"https://gitea.internal/api/v1":    false,
+		"https://10.1.2.3/api/v1":          false,
+		"https://gitea.example.com/api/v1": true,
+	}
+	for target, wantProxy := range cases {
+		req, _ := http.NewRequest(http.MethodGet, target, nil)
+		got, err := proxy(req)
+		if err != nil {
+			t.Fatalf("unexpected error for %s: %s", target, err)
+		}
+		if (got != nil) != wantProxy {
+			t.Errorf("%s: expected proxied=%t, got proxy %v", target, wantProxy, got)
+		}
+	}
+}
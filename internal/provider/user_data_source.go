@@ -219,19 +219,21 @@ func (d *userDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	d.client = client
+	d.client = providerData.client
 }
 
 func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	client := d.client.WithContext(ctx)
+
 	var data userDataSourceModel
 
 	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
@@ -256,7 +258,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	if !data.Username.IsNull() {
 		// Query by username
 		username := data.Username.ValueString()
-		user, httpResp, err = d.client.GetUserInfo(username)
+		user, httpResp, err = client.GetUserInfo(username)
 		if err != nil {
 			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
 				resp.Diagnostics.AddError(
@@ -274,7 +276,7 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 	} else {
 		// Query by ID - need to use admin API
 		userID := data.Id.ValueInt64()
-		user, httpResp, err = d.client.GetUserByID(userID)
+		user, httpResp, err = client.GetUserByID(userID)
 		if err != nil {
 			if httpResp != nil && httpResp.StatusCode == http.StatusNotFound {
 				resp.Diagnostics.AddError(
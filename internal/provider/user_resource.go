@@ -20,6 +20,7 @@ var (
 	_ resource.Resource                = &userResource{}
 	_ resource.ResourceWithConfigure   = &userResource{}
 	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithModifyPlan  = &userResource{}
 )
 
 func NewUserResource() resource.Resource {
@@ -27,7 +28,8 @@ func NewUserResource() resource.Resource {
 }
 
 type userResource struct {
-	client *gitea.Client
+	client       *gitea.Client
+	providerData *giteaProviderData
 }
 
 type userResourceModel struct {
@@ -255,19 +257,39 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*gitea.Client)
+	providerData, ok := req.ProviderData.(*giteaProviderData)
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *gitea.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *giteaProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 		return
 	}
 
-	r.client = client
+	r.client = providerData.client
+	r.providerData = providerData
+}
+
+// ModifyPlan fails planning early when the authenticated user is not a site
+// administrator, since users can only be managed through admin endpoints.
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.providerData == nil || req.Plan.Raw.Equal(req.State.Raw) {
+		return
+	}
+
+	endpoint := "AdminEditUser (PATCH /admin/users/{username})"
+	if req.State.Raw.IsNull() {
+		endpoint = "AdminCreateUser (POST /admin/users)"
+	} else if req.Plan.Raw.IsNull() {
+		endpoint = "AdminDeleteUser (DELETE /admin/users/{username})"
+	}
+
+	resp.Diagnostics.Append(r.providerData.checkAdminPrivilege("gitea_user", endpoint)...)
 }
 
 func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan userResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -287,7 +309,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Visibility:         (*gitea.VisibleType)(plan.Visibility.ValueStringPointer()),
 	}
 
-	user, _, err := r.client.AdminCreateUser(createOpts)
+	user, _, err := client.AdminCreateUser(createOpts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Creating User",
@@ -322,7 +344,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		!plan.AllowCreateOrganization.IsNull() || !plan.Restricted.IsNull()
 
 	if hasEditFields {
-		_, err = r.client.AdminEditUser(plan.Username.ValueString(), editOpts)
+		_, err = client.AdminEditUser(plan.Username.ValueString(), editOpts)
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Updating User After Creation",
@@ -332,7 +354,7 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		}
 
 		// Re-read the user to get updated values
-		user, _, err = r.client.GetUserInfo(plan.Username.ValueString())
+		user, _, err = client.GetUserInfo(plan.Username.ValueString())
 		if err != nil {
 			resp.Diagnostics.AddError(
 				"Error Reading User After Update",
@@ -352,6 +374,8 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 }
 
 func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state userResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -367,7 +391,7 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 	preserveMaxRepoCreation := state.MaxRepoCreation
 
 	// Get user from Gitea API
-	user, response, err := r.client.GetUserInfo(state.Username.ValueString())
+	user, response, err := client.GetUserInfo(state.Username.ValueString())
 	if err != nil {
 		// If user was deleted externally, remove from state
 		if response != nil && response.StatusCode == http.StatusNotFound {
@@ -395,6 +419,8 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 }
 
 func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	client := r.client.WithContext(ctx)
+
 	var plan userResourceModel
 	var state userResourceModel
 
@@ -432,7 +458,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		editOpts.MaxRepoCreation = &maxRepoInt
 	}
 
-	_, err := r.client.AdminEditUser(plan.Username.ValueString(), editOpts)
+	_, err := client.AdminEditUser(plan.Username.ValueString(), editOpts)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Updating User",
@@ -442,7 +468,7 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 	}
 
 	// Read back the user to get updated values
-	user, _, err := r.client.GetUserInfo(plan.Username.ValueString())
+	user, _, err := client.GetUserInfo(plan.Username.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Reading User After Update",
@@ -458,6 +484,8 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 }
 
 func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	client := r.client.WithContext(ctx)
+
 	var state userResourceModel
 
 	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
@@ -466,7 +494,7 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 	}
 
 	// Delete user via Gitea API
-	_, err := r.client.AdminDeleteUser(state.Username.ValueString())
+	_, err := client.AdminDeleteUser(state.Username.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Error Deleting User",
@@ -477,11 +505,13 @@ func (r *userResource) Delete(ctx context.Context, req resource.DeleteRequest, r
 }
 
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	client := r.client.WithContext(ctx)
+
 	// Import using the username
 	username := req.ID
 
 	// Fetch the user from Gitea
-	user, httpResp, err := r.client.GetUserInfo(username)
+	user, httpResp, err := client.GetUserInfo(username)
 	if err != nil {
 		if httpResp != nil && httpResp.StatusCode == 404 {
 			resp.Diagnostics.AddError(